@@ -0,0 +1,3 @@
+data "netbox_asn_range" "racks" {
+  name = "racks"
+}
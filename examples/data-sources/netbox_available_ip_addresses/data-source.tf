@@ -0,0 +1,8 @@
+data "netbox_prefix" "servers" {
+  cidr = "10.0.1.0/24"
+}
+
+data "netbox_available_ip_addresses" "servers" {
+  prefix_id = data.netbox_prefix.servers.id
+  limit     = 10
+}
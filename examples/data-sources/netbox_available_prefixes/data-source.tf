@@ -0,0 +1,15 @@
+data "netbox_prefix" "vips" {
+  cidr = "10.0.0.0/24"
+}
+
+# The next four /28 prefixes that could be allocated for VIPs
+data "netbox_available_prefixes" "vips" {
+  prefix_id           = data.netbox_prefix.vips.id
+  prefix_length       = 28
+  limit               = 4
+  include_utilization = true
+}
+
+output "vip_capacity" {
+  value = "${data.netbox_available_prefixes.vips.utilization}% used, next free: ${data.netbox_available_prefixes.vips.prefixes[0].prefix}"
+}
@@ -0,0 +1,6 @@
+data "netbox_l2vpns" "evpn" {
+  filter {
+    name  = "type"
+    value = "vxlan-evpn"
+  }
+}
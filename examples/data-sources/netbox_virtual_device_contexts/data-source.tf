@@ -0,0 +1,6 @@
+data "netbox_virtual_device_contexts" "test" {
+  filter {
+    name  = "device_id"
+    value = netbox_device.test.id
+  }
+}
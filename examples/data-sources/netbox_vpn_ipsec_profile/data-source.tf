@@ -0,0 +1,3 @@
+data "netbox_vpn_ipsec_profile" "default" {
+  name = "my-ipsec-profile"
+}
@@ -0,0 +1,4 @@
+data "netbox_wireless_lan" "staff" {
+  ssid     = "staff"
+  group_id = data.netbox_wireless_lan_group.campus.id
+}
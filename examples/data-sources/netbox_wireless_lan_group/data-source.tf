@@ -0,0 +1,3 @@
+data "netbox_wireless_lan_group" "campus" {
+  name = "Campus"
+}
@@ -0,0 +1,3 @@
+data "netbox_wireless_link" "bridge" {
+  interface_a_id = data.netbox_device_interfaces.radio.interfaces[0].id
+}
@@ -0,0 +1,11 @@
+resource "netbox_rir" "private" {
+  name       = "private"
+  is_private = true
+}
+
+resource "netbox_asn_range" "racks" {
+  name   = "racks"
+  rir_id = netbox_rir.private.id
+  start  = 4200000000
+  end    = 4200009999
+}
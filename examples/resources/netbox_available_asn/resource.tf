@@ -0,0 +1,11 @@
+data "netbox_asn_range" "racks" {
+  name = "racks"
+}
+
+# Allocate one private ASN per rack
+resource "netbox_available_asn" "rack" {
+  for_each = toset(["r01", "r02", "r03"])
+
+  asn_range_id = data.netbox_asn_range.racks.id
+  description  = "BGP ASN of rack ${each.key}"
+}
@@ -0,0 +1,15 @@
+data "netbox_prefix" "nodes" {
+  cidr = "10.0.0.0/24"
+}
+
+resource "netbox_available_ip_addresses" "node_pool" {
+  prefix_id = data.netbox_prefix.nodes.id
+
+  dynamic "address" {
+    for_each = range(3)
+    content {
+      dns_name    = "node-${address.key}.k8s.example.com"
+      description = "Kubernetes node ${address.key}"
+    }
+  }
+}
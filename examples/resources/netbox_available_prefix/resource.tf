@@ -7,3 +7,24 @@ resource "netbox_available_prefix" "test" {
   prefix_length    = 25
   status           = "active"
 }
+
+# Allocate a /24 from the first regional supernet with space left
+resource "netbox_available_prefix" "regional" {
+  parent_prefix_ids = [
+    data.netbox_prefix.eu_west.id,
+    data.netbox_prefix.eu_central.id,
+  ]
+  prefix_length = 24
+  status        = "active"
+}
+
+# Allocate a /24 from any prefix with the given role in a VRF
+resource "netbox_available_prefix" "by_role" {
+  prefix_length = 24
+  status        = "active"
+
+  parent_selector {
+    role_id = data.netbox_ipam_role.supernet.id
+    vrf_id  = data.netbox_vrf.production.id
+  }
+}
@@ -0,0 +1,22 @@
+resource "netbox_vlan_group" "testGroup" {
+  name       = "Group One"
+  slug       = "group-one"
+  vid_ranges = [[100, 199]]
+}
+
+# Allocate the lowest available VLAN ID between 150 and 159
+resource "netbox_available_vlan" "range" {
+  name     = "range-vlan"
+  status   = "active"
+  group_id = netbox_vlan_group.testGroup.id
+  vid_min  = 150
+  vid_max  = 159
+}
+
+# Allocate the first of the preferred VLAN IDs that is available
+resource "netbox_available_vlan" "preferred" {
+  name           = "preferred-vlan"
+  status         = "active"
+  group_id       = netbox_vlan_group.testGroup.id
+  preferred_vids = [110, 120, 130]
+}
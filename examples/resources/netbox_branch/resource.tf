@@ -0,0 +1,10 @@
+resource "netbox_branch" "maintenance" {
+  name        = "maintenance-2026-10"
+  description = "Rack moves of the October maintenance"
+
+  # Bump to pull in the changes made in the main schema in the meantime
+  sync_trigger = "1"
+
+  # Set to true once the changes made in the branch are reviewed
+  merge = false
+}
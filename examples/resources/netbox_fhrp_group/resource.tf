@@ -0,0 +1,14 @@
+resource "netbox_fhrp_group" "gateway" {
+  name      = "gateway"
+  protocol  = "vrrp3"
+  group_id  = 10
+  auth_type = "plaintext"
+  auth_key  = var.vrrp_key
+}
+
+resource "netbox_ip_address" "gateway" {
+  ip_address    = "10.0.0.1/24"
+  status        = "active"
+  role          = "vrrp"
+  fhrp_group_id = netbox_fhrp_group.gateway.id
+}
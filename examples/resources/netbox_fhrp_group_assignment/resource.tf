@@ -0,0 +1,13 @@
+resource "netbox_fhrp_group_assignment" "router_a" {
+  group_id       = netbox_fhrp_group.gateway.id
+  interface_type = "dcim.interface"
+  interface_id   = netbox_device_interface.router_a.id
+  priority       = 200
+}
+
+resource "netbox_fhrp_group_assignment" "router_b" {
+  group_id       = netbox_fhrp_group.gateway.id
+  interface_type = "dcim.interface"
+  interface_id   = netbox_device_interface.router_b.id
+  priority       = 100
+}
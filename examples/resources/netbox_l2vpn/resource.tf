@@ -0,0 +1,15 @@
+resource "netbox_route_target" "import" {
+  name = "65000:100"
+}
+
+resource "netbox_route_target" "export" {
+  name = "65000:200"
+}
+
+resource "netbox_l2vpn" "customer" {
+  name              = "customer-evpn"
+  type              = "vxlan-evpn"
+  identifier        = 10100
+  import_target_ids = [netbox_route_target.import.id]
+  export_target_ids = [netbox_route_target.export.id]
+}
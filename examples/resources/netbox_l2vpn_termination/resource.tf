@@ -0,0 +1,16 @@
+resource "netbox_l2vpn" "customer" {
+  name       = "customer-vxlan"
+  type       = "vxlan"
+  identifier = 10100
+}
+
+resource "netbox_vlan" "customer" {
+  name = "customer"
+  vid  = 100
+}
+
+resource "netbox_l2vpn_termination" "customer" {
+  l2vpn_id    = netbox_l2vpn.customer.id
+  object_type = "ipam.vlan"
+  object_id   = netbox_vlan.customer.id
+}
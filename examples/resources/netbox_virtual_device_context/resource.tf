@@ -0,0 +1,14 @@
+resource "netbox_virtual_device_context" "test" {
+  name       = "vdc-1"
+  device_id  = netbox_device.test.id
+  identifier = 1
+  status     = "active"
+  tenant_id  = netbox_tenant.test.id
+}
+
+resource "netbox_device_interface" "test" {
+  name      = "Ethernet1/1"
+  device_id = netbox_device.test.id
+  type      = "10gbase-x-sfpp"
+  vdc_ids   = [netbox_virtual_device_context.test.id]
+}
@@ -0,0 +1,14 @@
+resource "netbox_vpn_ike_proposal" "test" {
+  name                  = "my-ike-proposal"
+  authentication_method = "preshared-keys"
+  encryption_algorithm  = "aes-256-cbc"
+  group                 = 14
+}
+
+resource "netbox_vpn_ike_policy" "test" {
+  name          = "my-ike-policy"
+  version       = 1
+  mode          = "main"
+  proposal_ids  = [netbox_vpn_ike_proposal.test.id]
+  preshared_key = "secret"
+}
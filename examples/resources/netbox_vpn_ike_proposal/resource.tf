@@ -0,0 +1,8 @@
+resource "netbox_vpn_ike_proposal" "test" {
+  name                     = "my-ike-proposal"
+  authentication_method    = "preshared-keys"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+  group                    = 14
+  sa_lifetime              = 28800
+}
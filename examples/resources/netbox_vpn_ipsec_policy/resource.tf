@@ -0,0 +1,10 @@
+resource "netbox_vpn_ipsec_proposal" "test" {
+  name                 = "my-ipsec-proposal"
+  encryption_algorithm = "aes-256-gcm"
+}
+
+resource "netbox_vpn_ipsec_policy" "test" {
+  name         = "my-ipsec-policy"
+  proposal_ids = [netbox_vpn_ipsec_proposal.test.id]
+  pfs_group    = 14
+}
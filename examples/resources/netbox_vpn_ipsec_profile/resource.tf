@@ -0,0 +1,14 @@
+resource "netbox_vpn_ipsec_profile" "test" {
+  name            = "my-ipsec-profile"
+  mode            = "esp"
+  ike_policy_id   = netbox_vpn_ike_policy.test.id
+  ipsec_policy_id = netbox_vpn_ipsec_policy.test.id
+}
+
+resource "netbox_vpn_tunnel" "test" {
+  name             = "my-tunnel"
+  encapsulation    = "ipsec-tunnel"
+  status           = "active"
+  tunnel_group_id  = netbox_vpn_tunnel_group.test.id
+  ipsec_profile_id = netbox_vpn_ipsec_profile.test.id
+}
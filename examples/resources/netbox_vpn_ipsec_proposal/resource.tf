@@ -0,0 +1,6 @@
+resource "netbox_vpn_ipsec_proposal" "test" {
+  name                     = "my-ipsec-proposal"
+  encryption_algorithm     = "aes-256-gcm"
+  authentication_algorithm = "hmac-sha256"
+  sa_lifetime_seconds      = 3600
+}
@@ -0,0 +1,9 @@
+resource "netbox_wireless_lan" "staff" {
+  ssid        = "staff"
+  group_id    = netbox_wireless_lan_group.building_a.id
+  vlan_id     = netbox_vlan.staff.id
+  site_id     = netbox_site.campus.id
+  auth_type   = "wpa-personal"
+  auth_cipher = "aes"
+  auth_psk    = var.staff_psk
+}
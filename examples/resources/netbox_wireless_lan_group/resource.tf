@@ -0,0 +1,8 @@
+resource "netbox_wireless_lan_group" "campus" {
+  name = "Campus"
+}
+
+resource "netbox_wireless_lan_group" "building_a" {
+  name      = "Building A"
+  parent_id = netbox_wireless_lan_group.campus.id
+}
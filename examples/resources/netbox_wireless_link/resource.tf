@@ -0,0 +1,20 @@
+resource "netbox_device_interface" "radio_a" {
+  name      = "wlan0"
+  device_id = netbox_device.bridge_a.id
+  type      = "ieee802.11ac"
+}
+
+resource "netbox_device_interface" "radio_b" {
+  name      = "wlan0"
+  device_id = netbox_device.bridge_b.id
+  type      = "ieee802.11ac"
+}
+
+resource "netbox_wireless_link" "bridge" {
+  interface_a_id = netbox_device_interface.radio_a.id
+  interface_b_id = netbox_device_interface.radio_b.id
+  ssid           = "bridge"
+  auth_type      = "wpa-personal"
+  auth_cipher    = "aes"
+  auth_psk       = var.bridge_psk
+}
@@ -27,4 +27,8 @@ func main() {
 			return netbox.Provider()
 		},
 	})
+
+	// Terraform shuts the provider down gracefully after it is done, which
+	// gives us the chance to clean up tokens provisioned at startup.
+	netbox.RevokeProvisionedTokens()
 }
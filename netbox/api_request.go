@@ -0,0 +1,79 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// apiRequestError is returned for unsuccessful responses of requests sent
+// with netboxAPIRequest. It implements netboxAPIError, so validation errors
+// are reported like the ones of the generated client.
+type apiRequestError struct {
+	operation string
+	code      int
+	payload   interface{}
+}
+
+func (e *apiRequestError) Error() string {
+	return fmt.Sprintf("%s (status %d): %v", e.operation, e.code, e.payload)
+}
+
+func (e *apiRequestError) Code() int {
+	return e.code
+}
+
+func (e *apiRequestError) GetPayload() interface{} {
+	return e.payload
+}
+
+// netboxAPIRequest sends a request to a Netbox API the generated client does
+// not know or does not model correctly, like the one of the
+// netbox-branching plugin or bulk operations, and decodes the response into
+// result, if given.
+func netboxAPIRequest(ctx context.Context, transport runtime.ClientTransport, method, path string, query url.Values, body, result interface{}) error {
+	operation := fmt.Sprintf("%s %s", method, path)
+	_, err := transport.Submit(&runtime.ClientOperation{
+		ID:                 operation,
+		Method:             method,
+		PathPattern:        path,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Context:            ctx,
+		Params: runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+			for name, values := range query {
+				if err := r.SetQueryParam(name, values...); err != nil {
+					return err
+				}
+			}
+			if body == nil {
+				return nil
+			}
+			return r.SetBodyParam(body)
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			if response.Code() < 200 || response.Code() > 299 {
+				var payload interface{}
+				if err := consumer.Consume(response.Body(), &payload); err != nil {
+					payload = response.Message()
+				}
+				return nil, &apiRequestError{operation: operation, code: response.Code(), payload: payload}
+			}
+			if result == nil || response.Code() == http.StatusNoContent {
+				return nil, nil
+			}
+			return nil, consumer.Consume(response.Body(), result)
+		}),
+	})
+	return err
+}
+
+func isNotFound(err error) bool {
+	apiErr, ok := err.(netboxAPIError)
+	return ok && apiErr.Code() == http.StatusNotFound
+}
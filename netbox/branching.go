@@ -0,0 +1,122 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	// branchHeader selects the branch of the netbox-branching plugin a
+	// request operates in. Its value is the schema ID of the branch.
+	branchHeader = "X-NetBox-Branch"
+
+	branchesPath = "/plugins/branching/branches/"
+	jobsPath     = "/core/jobs/"
+)
+
+// branchPollInterval is the time between two checks of a branch or job that
+// is still in progress.
+var branchPollInterval = 5 * time.Second
+
+// netboxBranch is a branch of the netbox-branching plugin. The generated
+// client does not cover plugin APIs.
+type netboxBranch struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	SchemaID    string `json:"schema_id"`
+	Status      struct {
+		Value string `json:"value"`
+	} `json:"status"`
+	LastSync *string `json:"last_sync"`
+}
+
+// netboxJob is a background job, e.g. syncing or merging a branch.
+type netboxJob struct {
+	ID     int64 `json:"id"`
+	Status struct {
+		Value string `json:"value"`
+	} `json:"status"`
+	Error string `json:"error"`
+}
+
+func branchPath(id int64) string {
+	return fmt.Sprintf("%s%d/", branchesPath, id)
+}
+
+// findBranch returns the branch with the given name.
+func findBranch(ctx context.Context, transport runtime.ClientTransport, name string) (*netboxBranch, error) {
+	var res struct {
+		Results []*netboxBranch `json:"results"`
+	}
+	if err := netboxAPIRequest(ctx, transport, http.MethodGet, branchesPath, url.Values{"name": {name}}, nil, &res); err != nil {
+		return nil, fmt.Errorf("error listing branches, is the netbox-branching plugin installed? %w", err)
+	}
+	for _, branch := range res.Results {
+		if branch.Name == name {
+			return branch, nil
+		}
+	}
+	return nil, fmt.Errorf("could not find branch %q", name)
+}
+
+// waitForBranch waits until the branch with the given ID has left the pending
+// states and returns it. It fails if the branch ends up in another state than
+// target.
+func waitForBranch(ctx context.Context, transport runtime.ClientTransport, id int64, pending []string, target string, timeout time.Duration) (*netboxBranch, error) {
+	conf := &retry.StateChangeConf{
+		Pending:      pending,
+		Target:       []string{target},
+		Timeout:      timeout,
+		PollInterval: branchPollInterval,
+		Refresh: func() (interface{}, string, error) {
+			var branch netboxBranch
+			if err := netboxAPIRequest(ctx, transport, http.MethodGet, branchPath(id), nil, nil, &branch); err != nil {
+				return nil, "", err
+			}
+			return &branch, branch.Status.Value, nil
+		},
+	}
+	branch, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for branch %d to become %s: %w", id, target, err)
+	}
+	return branch.(*netboxBranch), nil
+}
+
+// runBranchJob starts the given operation on a branch, e.g. `sync` or
+// `merge`, and waits for the resulting job to complete.
+func runBranchJob(ctx context.Context, transport runtime.ClientTransport, id int64, operation string, timeout time.Duration) error {
+	var job netboxJob
+	path := fmt.Sprintf("%s%s/", branchPath(id), operation)
+	if err := netboxAPIRequest(ctx, transport, http.MethodPost, path, nil, map[string]interface{}{"commit": true}, &job); err != nil {
+		return fmt.Errorf("error starting %s of branch %d: %w", operation, id, err)
+	}
+
+	conf := &retry.StateChangeConf{
+		Pending:      []string{"pending", "scheduled", "running"},
+		Target:       []string{"completed"},
+		Timeout:      timeout,
+		PollInterval: branchPollInterval,
+		Refresh: func() (interface{}, string, error) {
+			var current netboxJob
+			if err := netboxAPIRequest(ctx, transport, http.MethodGet, fmt.Sprintf("%s%d/", jobsPath, job.ID), nil, nil, &current); err != nil {
+				return nil, "", err
+			}
+			if current.Error != "" {
+				return nil, "", fmt.Errorf("job %d failed: %s", job.ID, current.Error)
+			}
+			return &current, current.Status.Value, nil
+		},
+	}
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s of branch %d: %w", operation, id, err)
+	}
+	return nil
+}
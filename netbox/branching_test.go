@@ -0,0 +1,197 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fbreckle/go-netbox/netbox/client/status"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// branchingStub fakes the API of the netbox-branching plugin. Branches and
+// jobs report the given states one after another, the last one sticks.
+type branchingStub struct {
+	branchStates []string
+	jobStates    []string
+	jobError     string
+
+	branchHeaders map[string]string
+	requests      []string
+}
+
+func (s *branchingStub) next(states *[]string) string {
+	state := (*states)[0]
+	if len(*states) > 1 {
+		*states = (*states)[1:]
+	}
+	return state
+}
+
+func (s *branchingStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	s.branchHeaders[r.URL.Path] = r.Header.Get(branchHeader)
+	w.Header().Set("Content-Type", "application/json")
+
+	branch := map[string]interface{}{
+		"id":          1,
+		"name":        "feature",
+		"description": "",
+		"schema_id":   "td4f1jxq",
+		"last_sync":   nil,
+	}
+
+	var payload interface{}
+	switch r.Method + " " + r.URL.Path {
+	case "GET /api/plugins/branching/branches/":
+		if r.URL.Query().Get("name") != "feature" {
+			payload = map[string]interface{}{"count": 0, "results": []interface{}{}}
+			break
+		}
+		branch["status"] = map[string]string{"value": "ready"}
+		payload = map[string]interface{}{"count": 1, "results": []interface{}{branch}}
+	case "POST /api/plugins/branching/branches/":
+		w.WriteHeader(http.StatusCreated)
+		branch["status"] = map[string]string{"value": "new"}
+		payload = branch
+	case "GET /api/plugins/branching/branches/1/":
+		branch["status"] = map[string]string{"value": s.next(&s.branchStates)}
+		payload = branch
+	case "POST /api/plugins/branching/branches/1/sync/", "POST /api/plugins/branching/branches/1/merge/":
+		w.WriteHeader(http.StatusAccepted)
+		payload = map[string]interface{}{"id": 7, "status": map[string]string{"value": "pending"}}
+	case "GET /api/core/jobs/7/":
+		state := s.next(&s.jobStates)
+		job := map[string]interface{}{"id": 7, "status": map[string]string{"value": state}}
+		if state == "errored" {
+			job["error"] = s.jobError
+		}
+		payload = job
+	case "GET /api/status/":
+		payload = map[string]interface{}{}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		payload = map[string]string{"detail": "Not found."}
+	}
+	json.NewEncoder(w).Encode(payload)
+}
+
+func testBranchingClient(t *testing.T, stub *branchingStub, branch string) *providerState {
+	t.Helper()
+
+	interval := branchPollInterval
+	branchPollInterval = time.Millisecond
+	t.Cleanup(func() { branchPollInterval = interval })
+
+	stub.branchHeaders = make(map[string]string)
+	ts := httptest.NewServer(stub)
+	t.Cleanup(ts.Close)
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+		Branch:    branch,
+	}
+	netboxClient, err := config.Client()
+	require.NoError(t, err)
+	return &providerState{NetBoxAPI: netboxClient}
+}
+
+func TestBranchHeaderSet(t *testing.T) {
+	stub := &branchingStub{}
+	api := testBranchingClient(t, stub, "feature")
+
+	_, err := api.Status.StatusList(status.NewStatusListParams(), nil)
+	assert.NoError(t, err)
+
+	// the branch itself is looked up in the main schema
+	assert.Equal(t, "", stub.branchHeaders["/api/plugins/branching/branches/"])
+	assert.Equal(t, "td4f1jxq", stub.branchHeaders["/api/status/"])
+}
+
+func TestBranchHeaderUnknownBranch(t *testing.T) {
+	stub := &branchingStub{}
+	ts := httptest.NewServer(stub)
+	defer ts.Close()
+	stub.branchHeaders = make(map[string]string)
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+		Branch:    "unknown",
+	}
+	_, err := config.Client()
+	assert.ErrorContains(t, err, `could not find branch "unknown"`)
+}
+
+func TestResourceNetboxBranchCreateWaitsForProvisioning(t *testing.T) {
+	stub := &branchingStub{
+		branchStates: []string{"provisioning", "provisioning", "ready"},
+	}
+	api := testBranchingClient(t, stub, "")
+
+	d := resourceNetboxBranch().TestResourceData()
+	d.Set("name", "feature")
+
+	diags := resourceNetboxBranchCreate(context.Background(), d, api)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "1", d.Id())
+	assert.Equal(t, "ready", d.Get("status"))
+	assert.Equal(t, "td4f1jxq", d.Get("schema_id"))
+	assert.NotContains(t, stub.requests, "POST /api/plugins/branching/branches/1/merge/")
+}
+
+func TestResourceNetboxBranchCreateAndMerge(t *testing.T) {
+	stub := &branchingStub{
+		branchStates: []string{"ready", "merged"},
+		jobStates:    []string{"running", "running", "completed"},
+	}
+	api := testBranchingClient(t, stub, "")
+
+	d := resourceNetboxBranch().TestResourceData()
+	d.Set("name", "feature")
+	d.Set("merge", true)
+
+	diags := resourceNetboxBranchCreate(context.Background(), d, api)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Contains(t, stub.requests, "POST /api/plugins/branching/branches/1/merge/")
+	assert.Equal(t, "merged", d.Get("status"))
+}
+
+func TestResourceNetboxBranchJobFailure(t *testing.T) {
+	stub := &branchingStub{
+		jobStates: []string{"running", "errored"},
+		jobError:  "conflicting changes",
+	}
+	api := testBranchingClient(t, stub, "")
+
+	err := runBranchJob(context.Background(), api.Transport, 1, "sync", time.Minute)
+	assert.ErrorContains(t, err, "conflicting changes")
+}
+
+func TestResourceNetboxBranchTimeout(t *testing.T) {
+	stub := &branchingStub{
+		branchStates: []string{"provisioning"},
+	}
+	api := testBranchingClient(t, stub, "")
+
+	_, err := waitForBranch(context.Background(), api.Transport, 1, []string{"new", "provisioning"}, "ready", 50*time.Millisecond)
+	assert.ErrorContains(t, err, "timeout")
+}
+
+func TestResourceNetboxBranchReadGone(t *testing.T) {
+	stub := &branchingStub{}
+	api := testBranchingClient(t, stub, "")
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxBranch().Schema, map[string]interface{}{"name": "feature"})
+	d.SetId("2")
+
+	diags := resourceNetboxBranchRead(context.Background(), d, api)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "", d.Id())
+}
@@ -0,0 +1,38 @@
+package netbox
+
+import (
+	"github.com/hashicorp/go-version"
+)
+
+// netboxFeature is a Netbox feature that is not available in every Netbox
+// version the provider may talk to.
+type netboxFeature string
+
+const (
+	featureChangelogMessage netboxFeature = "changelog messages"
+)
+
+// netboxCapabilities maps every feature to the first Netbox version that
+// supports it.
+var netboxCapabilities = map[netboxFeature]*version.Version{
+	featureChangelogMessage: version.Must(version.NewVersion("4.4.0")),
+}
+
+// supportedNetboxVersions are the Netbox versions the provider was tested
+// against.
+var supportedNetboxVersions = version.MustConstraints(version.NewConstraint(">= 4.2.2, <= 4.2.9"))
+
+// supportsFeature reports whether the given Netbox version supports the given
+// feature. If the version is unknown, e.g. because the version check was
+// skipped, all features are assumed to be supported.
+func supportsFeature(netboxVersion *version.Version, feature netboxFeature) bool {
+	if netboxVersion == nil {
+		return true
+	}
+	return netboxVersion.GreaterThanOrEqual(netboxCapabilities[feature])
+}
+
+// supports reports whether the connected Netbox supports the given feature.
+func (s *providerState) supports(feature netboxFeature) bool {
+	return supportsFeature(s.netboxVersion, feature)
+}
@@ -0,0 +1,67 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupportsFeature(t *testing.T) {
+	assert.True(t, supportsFeature(nil, featureChangelogMessage), "unknown version should support everything")
+	assert.False(t, supportsFeature(version.Must(version.NewVersion("4.2.9")), featureChangelogMessage))
+	assert.True(t, supportsFeature(version.Must(version.NewVersion("4.4.0")), featureChangelogMessage))
+
+	state := &providerState{netboxVersion: version.Must(version.NewVersion("4.2.2"))}
+	assert.False(t, state.supports(featureChangelogMessage))
+}
+
+func TestSupportedNetboxVersions(t *testing.T) {
+	for v, expected := range map[string]bool{
+		"4.1.11": false,
+		"4.2.2":  true,
+		"4.2.9":  true,
+		"4.3.0":  false,
+	} {
+		assert.Equal(t, expected, supportedNetboxVersions.Check(version.Must(version.NewVersion(v))), v)
+	}
+}
+
+func TestProviderConfigureChangelogMessageUnsupported(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"netbox-version": "4.2.9"}`))
+	}))
+	defer ts.Close()
+
+	for _, tc := range []struct {
+		message  string
+		expected bool
+	}{
+		{"", false},
+		{"managed by terraform", true},
+	} {
+		data := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+			"server_url":        ts.URL,
+			"api_token":         "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+			"changelog_message": tc.message,
+		})
+
+		_, diags := providerConfigure(context.Background(), data)
+		assert.False(t, diags.HasError(), "%v", diags)
+
+		found := false
+		for _, d := range diags {
+			if d.Severity == diag.Warning && d.Summary == "Changelog messages are not supported by this Netbox version" {
+				found = true
+				assert.Contains(t, d.Detail, "require Netbox >= 4.4.0")
+			}
+		}
+		assert.Equal(t, tc.expected, found, tc.message)
+	}
+}
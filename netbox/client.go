@@ -1,26 +1,67 @@
 package netbox
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptrace"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	netboxclient "github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
 	"github.com/goware/urlx"
+	"github.com/hashicorp/go-uuid"
 	log "github.com/sirupsen/logrus"
 )
 
 // Config struct for the netbox provider
 type Config struct {
 	APIToken                    string
+	AuthScheme                  string
+	Username                    string
+	Password                    string
+	TokenLifetime               int
 	ServerURL                   string
 	AllowInsecureHTTPS          bool
+	CACertFile                  string
+	CACertPEM                   string
+	ClientCertFile              string
+	ClientCertPEM               string
+	ClientKeyFile               string
+	ClientKeyPEM                string
+	TLSServerName               string
 	Headers                     map[string]interface{}
 	RequestTimeout              int
 	StripTrailingSlashesFromURL bool
+	MaxRetries                  int
+	RetryWaitMin                int
+	RetryWaitMax                int
+	MaxConcurrentRequests       int
+	RequestsPerSecond           float64
+	ChangelogMessage            string
+	Branch                      string
 }
 
+// requestIDHeader identifies a single request to Netbox, including its
+// retries, in the logs of the provider and of proxies in between.
+const requestIDHeader = "X-Request-ID"
+
+// requestIDField is the field of Netbox error responses the request ID of the
+// failed request is added to.
+const requestIDField = "request_id"
+
 // customHeaderTransport is a transport that adds the specified headers on
 // every request.
 type customHeaderTransport struct {
@@ -28,16 +69,93 @@ type customHeaderTransport struct {
 	headers  map[string]interface{}
 }
 
+// changelogTransport is a transport that adds a changelog message to every
+// request that creates, updates or deletes objects in Netbox.
+type changelogTransport struct {
+	original http.RoundTripper
+	message  string
+}
+
+// requestIDTransport is a transport that tags every request with a unique
+// X-Request-ID header, so a change in Netbox can be traced back to the
+// Terraform run that made it.
+type requestIDTransport struct {
+	original http.RoundTripper
+}
+
+// retryTransport is a transport that retries requests failing with transient
+// errors, waiting with exponential backoff between attempts. Only idempotent
+// requests are retried on arbitrary failures. Non-idempotent requests (e.g.
+// POST) are only retried when it is certain that Netbox never saw them.
+type retryTransport struct {
+	original   http.RoundTripper
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+}
+
+// attemptTimeoutTransport is a transport that limits the time of every single
+// attempt of a request, including reading the body. It sits below the retry
+// and throttle transports, so that neither waiting for a retry nor waiting for
+// a free slot eats up the time of an attempt.
+type attemptTimeoutTransport struct {
+	original http.RoundTripper
+	timeout  time.Duration
+}
+
+// operationTimeoutTransport is a client transport that clears the timeout of
+// every operation. The generated client sets a fixed default timeout on each
+// operation, which would otherwise cap a request including all of its retries.
+// Instead, the retry transport enforces the request timeout per attempt and
+// the context of the operation bounds the request as a whole.
+type operationTimeoutTransport struct {
+	runtime.ClientTransport
+}
+
+// throttleTransport is a transport that limits the number of requests in
+// flight and the rate at which new requests are started. A single instance is
+// shared by all resources and data sources of a provider.
+type throttleTransport struct {
+	original http.RoundTripper
+	// slots limits the number of concurrent requests, nil means unlimited
+	slots chan struct{}
+	// interval is the minimum time between two requests, zero means unlimited
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
 // Client does the heavy lifting of establishing a base Open API client to Netbox.
 func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 	log.WithFields(log.Fields{
 		"server_url": cfg.ServerURL,
 	}).Debug("Initializing Netbox client")
 
-	if cfg.APIToken == "" {
+	if cfg.APIToken == "" && cfg.Username == "" {
 		return nil, fmt.Errorf("missing netbox API key")
 	}
 
+	if cfg.Username != "" && cfg.Password == "" {
+		return nil, fmt.Errorf("missing password for netbox user %q", cfg.Username)
+	}
+
+	if cfg.MaxRetries < 0 {
+		return nil, fmt.Errorf("max_retries must not be negative, got %d", cfg.MaxRetries)
+	}
+
+	if cfg.MaxConcurrentRequests < 0 {
+		return nil, fmt.Errorf("max_concurrent_requests must not be negative, got %d", cfg.MaxConcurrentRequests)
+	}
+
+	if cfg.RequestsPerSecond < 0 {
+		return nil, fmt.Errorf("requests_per_second must not be negative, got %v", cfg.RequestsPerSecond)
+	}
+
+	if cfg.RetryWaitMin > cfg.RetryWaitMax {
+		return nil, fmt.Errorf("retry_wait_min (%d) must not be greater than retry_wait_max (%d)", cfg.RetryWaitMin, cfg.RetryWaitMax)
+	}
+
 	// parse serverUrl
 	parsedURL, urlParseError := urlx.Parse(cfg.ServerURL)
 	if urlParseError != nil {
@@ -51,8 +169,9 @@ func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 	}).Debug("Initializing Netbox Open API runtime client")
 
 	// build http client
-	clientOpts := httptransport.TLSClientOptions{
-		InsecureSkipVerify: cfg.AllowInsecureHTTPS,
+	clientOpts, err := cfg.tlsClientOptions()
+	if err != nil {
+		return nil, err
 	}
 
 	trans, err := httptransport.TLSTransport(clientOpts)
@@ -62,30 +181,187 @@ func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 
 	trans.(*http.Transport).Proxy = http.ProxyFromEnvironment
 
-	if len(cfg.Headers) > 0 {
+	// copied, the branch header is only added once the branch was looked up
+	headers := make(map[string]interface{}, len(cfg.Headers)+1)
+	for key, value := range cfg.Headers {
+		headers[key] = value
+	}
+
+	if len(headers) > 0 || cfg.Branch != "" {
 		log.WithFields(log.Fields{
 			"custom_headers": cfg.Headers,
 		}).Debug("Setting custom headers on every request to Netbox")
 
 		trans = customHeaderTransport{
 			original: trans,
-			headers:  cfg.Headers,
+			headers:  headers,
+		}
+	}
+
+	if cfg.ChangelogMessage != "" {
+		log.WithFields(log.Fields{
+			"changelog_message": cfg.ChangelogMessage,
+		}).Debug("Setting changelog message on every change in Netbox")
+
+		trans = changelogTransport{
+			original: trans,
+			message:  cfg.ChangelogMessage,
+		}
+	}
+
+	if cfg.RequestTimeout > 0 {
+		trans = attemptTimeoutTransport{
+			original: trans,
+			timeout:  time.Second * time.Duration(cfg.RequestTimeout),
 		}
 	}
 
+	if cfg.MaxConcurrentRequests > 0 || cfg.RequestsPerSecond > 0 {
+		log.WithFields(log.Fields{
+			"max_concurrent_requests": cfg.MaxConcurrentRequests,
+			"requests_per_second":     cfg.RequestsPerSecond,
+		}).Debug("Throttling requests to Netbox")
+
+		trans = newThrottleTransport(trans, cfg.MaxConcurrentRequests, cfg.RequestsPerSecond)
+	}
+
+	trans = &retryTransport{
+		original:   trans,
+		maxRetries: cfg.MaxRetries,
+		waitMin:    time.Second * time.Duration(cfg.RetryWaitMin),
+		waitMax:    time.Second * time.Duration(cfg.RetryWaitMax),
+	}
+
+	// outermost, so that all attempts of a request share the same ID
+	trans = requestIDTransport{
+		original: trans,
+	}
+
 	httpClient := &http.Client{
 		Transport: trans,
-		Timeout:   time.Second * time.Duration(cfg.RequestTimeout),
 	}
 
 	transport := httptransport.NewWithClient(parsedURL.Host, parsedURL.Path+netboxclient.DefaultBasePath, desiredRuntimeClientSchemes, httpClient)
-	transport.DefaultAuthentication = httptransport.APIKeyAuth("Authorization", "header", fmt.Sprintf("Token %v", cfg.APIToken))
 	transport.SetLogger(log.StandardLogger())
-	netboxClient := netboxclient.New(transport, nil)
+	clientTransport := operationTimeoutTransport{transport}
+	netboxClient := netboxclient.New(clientTransport, nil)
+
+	token := cfg.APIToken
+	provisionedTokenID := int64(0)
+	if cfg.Username != "" {
+		log.WithFields(log.Fields{
+			"username": cfg.Username,
+		}).Debug("Provisioning Netbox token with username and password")
+
+		token, provisionedTokenID, err = provisionToken(clientTransport, cfg.Username, cfg.Password, time.Minute*time.Duration(cfg.TokenLifetime))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	auth, err := tokenAuth(cfg.AuthScheme, token)
+	if err != nil {
+		return nil, err
+	}
+	transport.DefaultAuthentication = auth
+
+	if provisionedTokenID != 0 {
+		registerTokenRevocation(netboxClient.Users, provisionedTokenID, auth)
+	}
+
+	if cfg.Branch != "" {
+		branch, err := findBranch(context.Background(), clientTransport, cfg.Branch)
+		if err != nil {
+			return nil, err
+		}
+
+		log.WithFields(log.Fields{
+			"branch":    cfg.Branch,
+			"schema_id": branch.SchemaID,
+		}).Debug("Sending all requests to Netbox in a branch")
+
+		headers[branchHeader] = branch.SchemaID
+	}
 
 	return netboxClient, nil
 }
 
+// tlsClientOptions assembles the TLS settings for the connection to Netbox,
+// i.e. custom CAs and the client certificate used for mutual TLS.
+func (cfg *Config) tlsClientOptions() (httptransport.TLSClientOptions, error) {
+	opts := httptransport.TLSClientOptions{
+		InsecureSkipVerify: cfg.AllowInsecureHTTPS,
+		ServerName:         cfg.TLSServerName,
+		CA:                 cfg.CACertFile,
+	}
+
+	if cfg.CACertPEM != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cfg.CACertPEM)) {
+			return opts, fmt.Errorf("no valid certificate found in ca_cert_pem")
+		}
+		opts.LoadedCAPool = pool
+	}
+
+	certPEM, err := readPEM(cfg.ClientCertFile, cfg.ClientCertPEM)
+	if err != nil {
+		return opts, fmt.Errorf("error reading client certificate: %w", err)
+	}
+	keyPEM, err := readPEM(cfg.ClientKeyFile, cfg.ClientKeyPEM)
+	if err != nil {
+		return opts, fmt.Errorf("error reading client key: %w", err)
+	}
+
+	switch {
+	case certPEM == nil && keyPEM == nil:
+		// no mutual TLS
+	case certPEM == nil:
+		return opts, fmt.Errorf("a client key was given without a client certificate")
+	case keyPEM == nil:
+		return opts, fmt.Errorf("a client certificate was given without a client key")
+	default:
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return opts, fmt.Errorf("error loading client certificate: %w", err)
+		}
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return opts, fmt.Errorf("error parsing client certificate: %w", err)
+		}
+		opts.LoadedCertificate = leaf
+		opts.LoadedKey = cert.PrivateKey
+	}
+
+	return opts, nil
+}
+
+// readPEM returns the PEM data either read from file or given inline. It
+// returns nil if neither is set.
+func readPEM(file, inline string) ([]byte, error) {
+	if file != "" {
+		return os.ReadFile(file)
+	}
+	if inline != "" {
+		return []byte(inline), nil
+	}
+	return nil, nil
+}
+
+// Submit sends the operation without the timeout set by its parameters.
+func (t operationTimeoutTransport) Submit(operation *runtime.ClientOperation) (interface{}, error) {
+	params := operation.Params
+	op := *operation
+	op.Params = runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, registry strfmt.Registry) error {
+		if params != nil {
+			if err := params.WriteToRequest(r, registry); err != nil {
+				return err
+			}
+		}
+		return r.SetTimeout(0)
+	})
+	return t.ClientTransport.Submit(&op)
+}
+
 // RoundTrip adds the headers specified in the transport on every request.
 func (t customHeaderTransport) RoundTrip(r *http.Request) (*http.Response, error) {
 	for key, value := range t.headers {
@@ -95,3 +371,380 @@ func (t customHeaderTransport) RoundTrip(r *http.Request) (*http.Response, error
 	resp, err := t.original.RoundTrip(r)
 	return resp, err
 }
+
+// RoundTrip adds the changelog message to the JSON body of requests changing
+// objects. A request without body, e.g. deleting a single object, gets a body
+// only holding the message. Provisioning a token does not change any object,
+// its body is sent unchanged.
+func (t changelogTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	switch r.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return t.original.RoundTrip(r)
+	}
+	if strings.HasSuffix(r.URL.Path, tokenProvisionPath) {
+		return t.original.RoundTrip(r)
+	}
+
+	var body []byte
+	if r.Body != nil && r.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r = r.Clone(r.Context())
+	body = addChangelogMessage(body, t.message)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	r.ContentLength = int64(len(body))
+	if r.Header.Get("Content-Type") == "" {
+		r.Header.Set("Content-Type", "application/json")
+	}
+
+	return t.original.RoundTrip(r)
+}
+
+// addChangelogMessage sets the `changelog_message` field of the given JSON
+// object, or of every object of a JSON array as sent by bulk operations. A
+// message already present is kept. Bodies that are not JSON are returned
+// unchanged.
+func addChangelogMessage(body []byte, message string) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		body = []byte("{}")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return body
+	}
+
+	objects := []interface{}{data}
+	if list, ok := data.([]interface{}); ok {
+		objects = list
+	}
+	for _, object := range objects {
+		if fields, ok := object.(map[string]interface{}); ok {
+			if _, ok := fields["changelog_message"]; !ok {
+				fields["changelog_message"] = message
+			}
+		}
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return body
+	}
+	return encoded
+}
+
+// RoundTrip sets a new request ID on the request. If the request fails, the
+// request ID is added to the error, so it shows up in the diagnostics of the
+// failed operation.
+func (t requestIDTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	requestID, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, err
+	}
+	r = r.Clone(r.Context())
+	r.Header.Set(requestIDHeader, requestID)
+
+	resp, err := t.original.RoundTrip(r)
+
+	fields := log.Fields{
+		"method": r.Method,
+		"url":    r.URL.String(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		log.WithFields(fields).Debug("Sent request to Netbox")
+		return nil, fmt.Errorf("request ID %s: %w", requestID, err)
+	}
+	fields["status"] = resp.StatusCode
+	log.WithFields(fields).Debug("Sent request to Netbox")
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("request ID %s: %w", requestID, err)
+		}
+		body = addRequestID(body, requestID)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		resp.ContentLength = int64(len(body))
+		resp.Header.Del("Content-Length")
+	}
+
+	return resp, nil
+}
+
+// addRequestID adds the request ID to the JSON object of an error response
+// sent by Netbox. The generated client includes the decoded body in its
+// errors, so the request ID ends up in the diagnostics. Bodies that are not
+// JSON objects are returned unchanged.
+func addRequestID(body []byte, requestID string) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var data map[string]interface{}
+	if err := decoder.Decode(&data); err != nil || data == nil {
+		return body
+	}
+	if _, ok := data[requestIDField]; ok {
+		return body
+	}
+	data[requestIDField] = requestID
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return body
+	}
+	return encoded
+}
+
+func newThrottleTransport(original http.RoundTripper, maxConcurrent int, perSecond float64) *throttleTransport {
+	t := &throttleTransport{
+		original: original,
+	}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	if perSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+	return t
+}
+
+// RoundTrip waits until the limits allow another request and sends it. The
+// concurrency slot is held until the response body was closed.
+func (t *throttleTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := sync.OnceFunc(func() {
+		if t.slots != nil {
+			<-t.slots
+		}
+	})
+
+	if err := t.waitForTurn(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	resp, err := t.original.RoundTrip(r)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: release}
+	return resp, nil
+}
+
+// waitForTurn blocks until the configured request rate allows the next
+// request to be started.
+func (t *throttleTransport) waitForTurn(ctx context.Context) error {
+	if t.interval == 0 {
+		return nil
+	}
+
+	t.mu.Lock()
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+	wait := t.next.Sub(now)
+	t.next = t.next.Add(t.interval)
+	t.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// RoundTrip sends the request and retries it on transient failures.
+func (t *retryTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		req := r
+		if attempt > 0 && r.Body != nil {
+			// the previous attempt consumed the body, get a fresh copy
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = r.Clone(r.Context())
+			req.Body = body
+		}
+
+		resp, sent, err := t.roundTripOnce(req)
+
+		if attempt >= t.maxRetries || !t.shouldRetry(r, resp, sent, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if deadline, ok := r.Context().Deadline(); ok && time.Until(deadline) < wait {
+			// the next attempt could not finish in time anyway
+			return resp, err
+		}
+
+		fields := log.Fields{
+			"request_id": r.Header.Get(requestIDHeader),
+			"method":     r.Method,
+			"url":        r.URL.String(),
+			"attempt":    attempt + 1,
+			"wait":       wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			drainBody(resp.Body)
+		}
+		log.WithFields(fields).Warn("Retrying failed request to Netbox")
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-r.Context().Done():
+			timer.Stop()
+			return nil, r.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// roundTripOnce performs a single attempt of the request. The returned bool
+// reports whether any part of the request was written to the connection.
+func (t *retryTransport) roundTripOnce(r *http.Request) (*http.Response, bool, error) {
+	var sent atomic.Bool
+	trace := &httptrace.ClientTrace{
+		WroteHeaderField: func(string, []string) { sent.Store(true) },
+	}
+	ctx := httptrace.WithClientTrace(r.Context(), trace)
+
+	resp, err := t.original.RoundTrip(r.WithContext(ctx))
+	return resp, sent.Load(), err
+}
+
+// RoundTrip sends the request with a timeout that lasts until the response
+// body was closed.
+func (t attemptTimeoutTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(r.Context(), t.timeout)
+
+	resp, err := t.original.RoundTrip(r.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// keep the attempt's context alive until the body was consumed
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// shouldRetry decides whether a failed attempt may be repeated.
+func (t *retryTransport) shouldRetry(r *http.Request, resp *http.Response, sent bool, err error) bool {
+	if r.Context().Err() != nil {
+		return false
+	}
+	if r.Body != nil && r.GetBody == nil {
+		// the body cannot be replayed
+		return false
+	}
+
+	if err != nil {
+		// a request that never left the client cannot have changed anything
+		return !sent || isIdempotent(r.Method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		// rejected before being processed
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(r.Method)
+	}
+	return false
+}
+
+// backoff returns the time to wait before the next attempt. A Retry-After
+// header sent by the server takes precedence, capped at the maximum wait.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return max(t.waitMin, min(wait, t.waitMax))
+		}
+	}
+
+	wait := t.waitMin << attempt
+	if wait > t.waitMax || wait < t.waitMin {
+		// also guards against overflow for large attempt counts
+		wait = t.waitMax
+	}
+	return wait
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either
+// a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func drainBody(body io.ReadCloser) {
+	// reading (some of) the body allows the connection to be reused
+	io.Copy(io.Discard, io.LimitReader(body, 4096)) //nolint:errcheck
+	body.Close()
+}
+
+// cancelOnCloseBody calls cancel once the response body was closed. It is
+// used to release resources that are bound to the lifetime of a response.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
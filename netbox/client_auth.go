@@ -0,0 +1,160 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fbreckle/go-netbox/netbox/client/users"
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	authSchemeAuto   = "auto"
+	authSchemeToken  = "token"
+	authSchemeBearer = "bearer"
+
+	// v2 tokens, introduced in Netbox 4.5, carry this prefix
+	tokenV2Prefix = "nbt_"
+)
+
+var authSchemeOptions = []string{authSchemeAuto, authSchemeToken, authSchemeBearer}
+
+// provisionedTokens holds the revocation functions of all tokens provisioned
+// via username and password, so they can be revoked on provider shutdown.
+var provisionedTokens struct {
+	sync.Mutex
+	revoke []func()
+}
+
+// authorizationHeader returns the value of the Authorization header for the
+// given token. In auto mode, the scheme is derived from the token format.
+func authorizationHeader(scheme, token string) (string, error) {
+	if scheme == "" || scheme == authSchemeAuto {
+		scheme = authSchemeToken
+		if strings.HasPrefix(token, tokenV2Prefix) {
+			scheme = authSchemeBearer
+		}
+	}
+
+	switch scheme {
+	case authSchemeToken:
+		return fmt.Sprintf("Token %v", token), nil
+	case authSchemeBearer:
+		return fmt.Sprintf("Bearer %v", token), nil
+	default:
+		return "", fmt.Errorf("unknown auth scheme %q, expected one of %s", scheme, strings.Join(authSchemeOptions, ", "))
+	}
+}
+
+// tokenProvisionPath is the endpoint that exchanges username and password for
+// a token.
+const tokenProvisionPath = "/users/tokens/provision/"
+
+// provisionToken logs into Netbox with username and password and returns a
+// new token that expires after the given lifetime, together with its ID.
+func provisionToken(transport runtime.ClientTransport, username, password string, lifetime time.Duration) (string, int64, error) {
+	body := map[string]interface{}{
+		"username":    username,
+		"password":    password,
+		"description": "Provisioned by terraform-provider-netbox",
+	}
+	if lifetime > 0 {
+		body["expires"] = time.Now().Add(lifetime).UTC().Format(time.RFC3339)
+	}
+
+	result, err := transport.Submit(&runtime.ClientOperation{
+		ID:                 "users_tokens_provision_create",
+		Method:             "POST",
+		PathPattern:        tokenProvisionPath,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params: runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+			return r.SetBodyParam(body)
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			var payload map[string]interface{}
+			if err := consumer.Consume(response.Body(), &payload); err != nil {
+				return nil, err
+			}
+			if response.Code() != 201 {
+				return nil, runtime.NewAPIError("token provisioning failed", payload, response.Code())
+			}
+			return payload, nil
+		}),
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("error provisioning token for user %q: %w", username, err)
+	}
+
+	payload := result.(map[string]interface{})
+	key, _ := payload["key"].(string)
+
+	// the JSON consumer decodes numbers as json.Number
+	var id int64
+	if number, ok := payload["id"].(json.Number); ok {
+		id, _ = number.Int64()
+	}
+
+	// v2 tokens only reveal their secret once, on creation. The full token
+	// consists of the prefix, the key and the secret.
+	if secret, ok := payload["token"].(string); ok && secret != "" {
+		if !strings.HasPrefix(secret, tokenV2Prefix) {
+			secret = fmt.Sprintf("%s%s.%s", tokenV2Prefix, key, secret)
+		}
+		return secret, id, nil
+	}
+
+	if key == "" {
+		return "", 0, fmt.Errorf("error provisioning token for user %q: no token in response", username)
+	}
+	return key, id, nil
+}
+
+// registerTokenRevocation remembers to delete the token with the given ID
+// when the provider shuts down.
+func registerTokenRevocation(api users.ClientService, id int64, auth runtime.ClientAuthInfoWriter) {
+	provisionedTokens.Lock()
+	defer provisionedTokens.Unlock()
+
+	provisionedTokens.revoke = append(provisionedTokens.revoke, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		params := users.NewUsersTokensDeleteParams().WithContext(ctx).WithID(id)
+		if _, err := api.UsersTokensDelete(params, auth); err != nil {
+			log.WithFields(log.Fields{
+				"token_id": id,
+				"error":    err.Error(),
+			}).Warn("Could not revoke provisioned Netbox token, it stays valid until it expires")
+		}
+	})
+}
+
+// RevokeProvisionedTokens deletes all tokens that were provisioned via
+// username and password. It is meant to be called once the provider process
+// is shutting down.
+func RevokeProvisionedTokens() {
+	provisionedTokens.Lock()
+	defer provisionedTokens.Unlock()
+
+	for _, revoke := range provisionedTokens.revoke {
+		revoke()
+	}
+	provisionedTokens.revoke = nil
+}
+
+// tokenAuth returns the authentication writer for the given token.
+func tokenAuth(scheme, token string) (runtime.ClientAuthInfoWriter, error) {
+	header, err := authorizationHeader(scheme, token)
+	if err != nil {
+		return nil, err
+	}
+	return httptransport.APIKeyAuth("Authorization", "header", header), nil
+}
@@ -0,0 +1,160 @@
+package netbox
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/status"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizationHeader(t *testing.T) {
+	for _, tc := range []struct {
+		scheme   string
+		token    string
+		expected string
+	}{
+		{"", "0123456789abcdef", "Token 0123456789abcdef"},
+		{authSchemeAuto, "0123456789abcdef", "Token 0123456789abcdef"},
+		{authSchemeAuto, "nbt_abc.0123456789abcdef", "Bearer nbt_abc.0123456789abcdef"},
+		{authSchemeToken, "nbt_abc.0123456789abcdef", "Token nbt_abc.0123456789abcdef"},
+		{authSchemeBearer, "0123456789abcdef", "Bearer 0123456789abcdef"},
+	} {
+		header, err := authorizationHeader(tc.scheme, tc.token)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, header)
+	}
+
+	_, err := authorizationHeader("basic", "0123456789abcdef")
+	assert.Error(t, err)
+}
+
+func TestBearerTokenSent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer nbt_abc.0123456789abcdef", r.Header.Get("Authorization"))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "nbt_abc.0123456789abcdef",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	client.Status.StatusList(req, nil)
+}
+
+// testTokenServer simulates the token provisioning endpoint of Netbox. The
+// given response is returned for valid credentials.
+func testTokenServer(t *testing.T, response map[string]interface{}, expectedAuth string) (*httptest.Server, *[]string) {
+	var deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/users/tokens/provision/":
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "terraform", body["username"])
+			assert.NotEmpty(t, body["expires"])
+			assert.Empty(t, r.Header.Get("Authorization"))
+
+			if body["password"] != "secret" {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"detail": "Invalid user credentials."}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(response)
+		case r.Method == http.MethodDelete:
+			assert.Equal(t, expectedAuth, r.Header.Get("Authorization"))
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			assert.Equal(t, expectedAuth, r.Header.Get("Authorization"))
+			w.Write([]byte(`{}`))
+		}
+	}))
+	return ts, &deleted
+}
+
+func TestProvisionTokenWithUsernameAndPassword(t *testing.T) {
+	ts, deleted := testTokenServer(t, map[string]interface{}{
+		"id":  42,
+		"key": "0123456789abcdef0123456789abcdef01234567",
+	}, "Token 0123456789abcdef0123456789abcdef01234567")
+	defer ts.Close()
+
+	config := Config{
+		ServerURL:     ts.URL,
+		Username:      "terraform",
+		Password:      "secret",
+		TokenLifetime: 10,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.NoError(t, err)
+
+	RevokeProvisionedTokens()
+	assert.Equal(t, []string{"/api/users/tokens/42/"}, *deleted)
+}
+
+func TestProvisionV2Token(t *testing.T) {
+	ts, _ := testTokenServer(t, map[string]interface{}{
+		"id":      42,
+		"version": 2,
+		"key":     "abcdef123456",
+		"token":   "0123456789abcdef0123456789abcdef01234567",
+	}, "Bearer nbt_abcdef123456.0123456789abcdef0123456789abcdef01234567")
+	defer ts.Close()
+
+	config := Config{
+		ServerURL:     ts.URL,
+		Username:      "terraform",
+		Password:      "secret",
+		TokenLifetime: 10,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.NoError(t, err)
+
+	RevokeProvisionedTokens()
+}
+
+func TestProvisionTokenWithWrongPasswordShouldFail(t *testing.T) {
+	ts, _ := testTokenServer(t, nil, "")
+	defer ts.Close()
+
+	config := Config{
+		ServerURL:     ts.URL,
+		Username:      "terraform",
+		Password:      "wrong",
+		TokenLifetime: 10,
+	}
+
+	_, err := config.Client()
+	assert.Error(t, err)
+}
+
+func TestUsernameWithoutPasswordShouldFail(t *testing.T) {
+	config := Config{
+		ServerURL: "http://localhost",
+		Username:  "terraform",
+	}
+
+	_, err := config.Client()
+	assert.Error(t, err)
+}
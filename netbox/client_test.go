@@ -1,11 +1,28 @@
 package netbox
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/fbreckle/go-netbox/netbox/client/status"
+	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -87,6 +104,489 @@ func TestAdditionalHeadersSet(t *testing.T) {
 	client.Status.StatusList(req, nil)
 }
 
-/* TODO
-func TestInvalidHttpsCertificate(t *testing.T) {}
-*/
+func TestAddChangelogMessage(t *testing.T) {
+	for _, tc := range []struct {
+		body     string
+		expected string
+	}{
+		{``, `{"changelog_message":"run 42"}`},
+		{`{"name": "test", "vid": 12}`, `{"changelog_message":"run 42","name":"test","vid":12}`},
+		{`{"name": "test", "changelog_message": "manual"}`, `{"changelog_message":"manual","name":"test"}`},
+		{`[{"id": 1}, {"id": 2}]`, `[{"changelog_message":"run 42","id":1},{"changelog_message":"run 42","id":2}]`},
+		{`not json`, `not json`},
+	} {
+		assert.Equal(t, tc.expected, string(addChangelogMessage([]byte(tc.body), "run 42")), tc.body)
+	}
+}
+
+func TestChangelogMessageSentOnWrites(t *testing.T) {
+	bodies := make(map[string]string)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies[r.Method] = string(body)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: changelogTransport{original: http.DefaultTransport, message: "run 42"}}
+	for _, method := range []string{http.MethodGet, http.MethodPatch, http.MethodDelete} {
+		var body io.Reader
+		if method == http.MethodPatch {
+			body = strings.NewReader(`{"name": "test"}`)
+		}
+		req, err := http.NewRequest(method, ts.URL, body)
+		assert.NoError(t, err)
+		_, err = client.Do(req)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, "", bodies[http.MethodGet])
+	assert.Equal(t, `{"changelog_message":"run 42","name":"test"}`, bodies[http.MethodPatch])
+	assert.Equal(t, `{"changelog_message":"run 42"}`, bodies[http.MethodDelete])
+}
+
+func TestChangelogMessageNotSentOnTokenProvisioning(t *testing.T) {
+	var body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: changelogTransport{original: http.DefaultTransport, message: "run 42"}}
+	_, err := client.Post(ts.URL+"/api"+tokenProvisionPath, "application/json", strings.NewReader(`{"username": "admin"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"username": "admin"}`, body)
+}
+
+func TestRequestIDSharedByRetries(t *testing.T) {
+	var requestIDs []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestIDs = append(requestIDs, r.Header.Get(requestIDHeader))
+		if len(requestIDs) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: requestIDTransport{original: testRetryTransport(3)}}
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(ts.URL)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	assert.Len(t, requestIDs, 3)
+	assert.NotEmpty(t, requestIDs[0])
+	assert.Equal(t, requestIDs[0], requestIDs[1])
+	assert.NotEqual(t, requestIDs[1], requestIDs[2])
+}
+
+func TestAddRequestID(t *testing.T) {
+	for _, tc := range []struct {
+		body     string
+		expected string
+	}{
+		{`{"name": ["This field is required."]}`, `{"name":["This field is required."],"request_id":"abc"}`},
+		{`{"detail": "Not found.", "request_id": "other"}`, `{"detail": "Not found.", "request_id": "other"}`},
+		{`[{"id": 1}]`, `[{"id": 1}]`},
+		{`<h1>Server Error (500)</h1>`, `<h1>Server Error (500)</h1>`},
+		{``, ``},
+	} {
+		assert.Equal(t, tc.expected, string(addRequestID([]byte(tc.body), "abc")), tc.body)
+	}
+}
+
+func TestRequestIDAddedToErrorResponses(t *testing.T) {
+	var requestIDs []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestIDs = append(requestIDs, r.Header.Get(requestIDHeader))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/invalid" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		w.Write([]byte(`{"name": "test"}`))
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: requestIDTransport{original: http.DefaultTransport}}
+
+	resp, err := client.Get(ts.URL + "/valid")
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, `{"name": "test"}`, string(body))
+
+	resp, err = client.Get(ts.URL + "/invalid")
+	assert.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	assert.Equal(t, `{"name":"test","request_id":"`+requestIDs[1]+`"}`, string(body))
+	assert.Equal(t, int64(len(body)), resp.ContentLength)
+}
+
+func TestRetryWaitMinGreaterThanMaxShouldFail(t *testing.T) {
+	config := Config{
+		APIToken:     "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:    "http://localhost",
+		RetryWaitMin: 10,
+		RetryWaitMax: 1,
+	}
+
+	_, err := config.Client()
+	assert.Error(t, err)
+}
+
+func testRetryTransport(maxRetries int) *retryTransport {
+	return &retryTransport{
+		original:   http.DefaultTransport,
+		maxRetries: maxRetries,
+		waitMin:    time.Millisecond,
+		waitMax:    10 * time.Millisecond,
+	}
+}
+
+func TestRetryTransportRetriesIdempotentRequests(t *testing.T) {
+	var attempts atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: testRetryTransport(3)}
+	resp, err := client.Get(ts.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.EqualValues(t, 3, attempts.Load())
+}
+
+func TestRetryTransportGivesUpAfterMaxRetries(t *testing.T) {
+	var attempts atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: testRetryTransport(2)}
+	resp, err := client.Get(ts.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.EqualValues(t, 3, attempts.Load())
+}
+
+func TestRetryTransportDoesNotRetryPostOnServerError(t *testing.T) {
+	var attempts atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: testRetryTransport(3)}
+	resp, err := client.Post(ts.URL, "application/json", strings.NewReader(`{"name": "test"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.EqualValues(t, 1, attempts.Load())
+}
+
+func TestRetryTransportRetriesPostOnTooManyRequests(t *testing.T) {
+	var attempts atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := make([]byte, 32)
+		n, _ := r.Body.Read(body)
+		assert.Equal(t, `{"name": "test"}`, string(body[:n]))
+
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: testRetryTransport(3)}
+	resp, err := client.Post(ts.URL, "application/json", strings.NewReader(`{"name": "test"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.EqualValues(t, 2, attempts.Load())
+}
+
+func TestRetryTransportShouldRetryUnsentPost(t *testing.T) {
+	transport := testRetryTransport(3)
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost", strings.NewReader("{}"))
+
+	assert.True(t, transport.shouldRetry(req, nil, false, assert.AnError))
+	assert.False(t, transport.shouldRetry(req, nil, true, assert.AnError))
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := &retryTransport{
+		waitMin: time.Second,
+		waitMax: 5 * time.Second,
+	}
+
+	assert.Equal(t, time.Second, transport.backoff(0, nil))
+	assert.Equal(t, 2*time.Second, transport.backoff(1, nil))
+	assert.Equal(t, 4*time.Second, transport.backoff(2, nil))
+	assert.Equal(t, 5*time.Second, transport.backoff(3, nil))
+	assert.Equal(t, 5*time.Second, transport.backoff(100, nil))
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	assert.Equal(t, 3*time.Second, transport.backoff(0, resp))
+
+	resp.Header.Set("Retry-After", "120")
+	assert.Equal(t, 5*time.Second, transport.backoff(0, resp))
+}
+
+func TestOperationTimeoutTransportIgnoresParamsTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{}")) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	runtime := httptransport.New(strings.TrimPrefix(ts.URL, "http://"), "/api", []string{"http"})
+	params := status.NewStatusListParams().WithTimeout(10 * time.Millisecond)
+
+	_, err := status.New(runtime, nil).StatusList(params, nil)
+	assert.Error(t, err)
+
+	_, err = status.New(operationTimeoutTransport{runtime}, nil).StatusList(params, nil)
+	assert.NoError(t, err)
+}
+
+func TestThrottleTransportLimitsConcurrency(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: newThrottleTransport(http.DefaultTransport, 2, 0)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(ts.URL)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestThrottleTransportWaitDoesNotCountAgainstAttemptTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(30 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	attempt := attemptTimeoutTransport{original: http.DefaultTransport, timeout: 50 * time.Millisecond}
+	client := &http.Client{Transport: newThrottleTransport(attempt, 1, 0)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(ts.URL)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAttemptTimeoutTransport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: attemptTimeoutTransport{original: http.DefaultTransport, timeout: 10 * time.Millisecond}}
+	_, err := client.Get(ts.URL)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestThrottleTransportLimitsRate(t *testing.T) {
+	transport := newThrottleTransport(http.DefaultTransport, 0, 100)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, transport.waitForTurn(context.Background()))
+	}
+
+	// the first request starts immediately, the others 10ms apart
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+// testClientCertificate returns a self-signed client certificate and its
+// private key, both PEM encoded.
+func testClientCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPEM), string(keyPEM)
+}
+
+func testServerCAPEM(ts *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}))
+}
+
+func TestCustomCAPEM(t *testing.T) {
+	var called atomic.Bool
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called.Store(true)
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+		CACertPEM: testServerCAPEM(ts),
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	client.Status.StatusList(req, nil)
+	assert.True(t, called.Load())
+}
+
+func TestCustomCAFileAndServerName(t *testing.T) {
+	var called atomic.Bool
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called.Store(true)
+	}))
+	defer ts.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	assert.NoError(t, os.WriteFile(caFile, []byte(testServerCAPEM(ts)), 0o600))
+
+	config := Config{
+		APIToken:   "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:  ts.URL,
+		CACertFile: caFile,
+		// the test certificate is issued for example.com
+		TLSServerName: "example.com",
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	client.Status.StatusList(req, nil)
+	assert.True(t, called.Load())
+}
+
+func TestInvalidHttpsCertificate(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: ts.URL,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	_, err = client.Status.StatusList(req, nil)
+	assert.Error(t, err)
+}
+
+func TestInvalidCAPEMShouldFail(t *testing.T) {
+	config := Config{
+		APIToken:  "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL: "https://localhost",
+		CACertPEM: "not a certificate",
+	}
+
+	_, err := config.Client()
+	assert.Error(t, err)
+}
+
+func TestMutualTLS(t *testing.T) {
+	var called atomic.Bool
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called.Store(true)
+		if assert.Len(t, r.TLS.PeerCertificates, 1) {
+			assert.Equal(t, "terraform", r.TLS.PeerCertificates[0].Subject.CommonName)
+		}
+	}))
+	ts.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	ts.StartTLS()
+	defer ts.Close()
+
+	certPEM, keyPEM := testClientCertificate(t)
+	keyFile := filepath.Join(t.TempDir(), "client.key")
+	assert.NoError(t, os.WriteFile(keyFile, []byte(keyPEM), 0o600))
+
+	config := Config{
+		APIToken:      "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:     ts.URL,
+		CACertPEM:     testServerCAPEM(ts),
+		ClientCertPEM: certPEM,
+		ClientKeyFile: keyFile,
+	}
+
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	req := status.NewStatusListParams()
+	client.Status.StatusList(req, nil)
+	assert.True(t, called.Load())
+}
+
+func TestClientCertificateWithoutKeyShouldFail(t *testing.T) {
+	certPEM, _ := testClientCertificate(t)
+
+	config := Config{
+		APIToken:      "07b12b765127747e4afd56cb531b7bf9c61f3c30",
+		ServerURL:     "https://localhost",
+		ClientCertPEM: certPEM,
+	}
+
+	_, err := config.Client()
+	assert.Error(t, err)
+}
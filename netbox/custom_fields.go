@@ -1,10 +1,22 @@
 package netbox
 
 import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-const customFieldsKey = "custom_fields"
+const (
+	customFieldsKey     = "custom_fields"
+	customFieldsJSONKey = "custom_fields_json"
+	customFieldsAllKey  = "custom_fields_all"
+)
 
 var customFieldsSchema = &schema.Schema{
 	Type:     schema.TypeMap,
@@ -16,6 +28,29 @@ var customFieldsSchema = &schema.Schema{
 	},
 }
 
+// customFieldsJSONSchema is added to every resource that has custom fields.
+// Unlike the string map of `custom_fields`, it keeps the native type of every
+// custom field value.
+var customFieldsJSONSchema = &schema.Schema{
+	Type:          schema.TypeString,
+	Optional:      true,
+	ConflictsWith: []string{customFieldsKey},
+	ValidateFunc:  validation.StringIsJSON,
+	DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+		equal, _ := jsonSemanticCompare(oldValue, newValue)
+		return equal
+	},
+	Description: "A JSON encoded object of custom field values, keeping their native types, e.g. `jsonencode({ rack_count = 4, decommissioned = false })`. Object and multi-object custom fields are set and read as the ID or list of IDs of the referenced objects.",
+}
+
+var customFieldsAllSchema = &schema.Schema{
+	Type:     schema.TypeMap,
+	Computed: true,
+	Elem: &schema.Schema{
+		Type: schema.TypeString,
+	},
+}
+
 func getCustomFields(cf interface{}) map[string]interface{} {
 	cfm, ok := cf.(map[string]interface{})
 	if !ok || len(cfm) == 0 {
@@ -23,3 +58,255 @@ func getCustomFields(cf interface{}) map[string]interface{} {
 	}
 	return cfm
 }
+
+// customFieldsFromResourceData returns the custom field values to send to
+// Netbox: the default custom fields of the provider, overridden by the values
+// of `custom_fields_json` or `custom_fields`. Custom fields that were removed
+// from the configuration are sent as null, so Netbox clears them. It returns
+// nil if there is nothing to send.
+func (s *providerState) customFieldsFromResourceData(d *schema.ResourceData) interface{} {
+	values := make(map[string]interface{})
+
+	oldJSON, newJSON := d.GetChange(customFieldsJSONKey)
+	oldMap, newMap := d.GetChange(customFieldsKey)
+	oldAll, _ := d.GetChange(customFieldsAllKey)
+	for _, old := range []map[string]interface{}{decodeCustomFieldsJSON(oldJSON), getCustomFields(oldMap), getCustomFields(oldAll)} {
+		for name := range old {
+			// Netbox keeps the values of custom fields that are not sent
+			if !s.customFieldIgnored(name) {
+				values[name] = nil
+			}
+		}
+	}
+
+	for name, value := range s.defaultCustomFields {
+		values[name] = value
+	}
+	for name, value := range decodeCustomFieldsJSON(newJSON) {
+		values[name] = value
+	}
+	for name, value := range getCustomFields(newMap) {
+		values[name] = value
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func decodeCustomFieldsJSON(v interface{}) map[string]interface{} {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return nil
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &values); err != nil {
+		return nil
+	}
+	return values
+}
+
+// readCustomFields sets the custom field values returned by Netbox. Values of
+// object and multi-object custom fields are reduced to IDs. All values are set
+// in `custom_fields_all`, while the default and ignored custom fields of the
+// provider are only kept in the resource's custom fields if they are
+// configured there. If
+// the resource uses `custom_fields_json`, unset custom fields are only kept if
+// they are configured, to match the configuration.
+func (s *providerState) readCustomFields(d *schema.ResourceData, apiCustomFields interface{}) {
+	cf := getCustomFields(apiCustomFields)
+	for name, value := range cf {
+		cf[name] = flattenCustomFieldValue(value)
+	}
+
+	if t := d.GetRawConfig().Type(); t.IsObjectType() && t.HasAttribute(customFieldsAllKey) {
+		d.Set(customFieldsAllKey, customFieldStrings(cf))
+	}
+
+	if !customFieldsJSONConfigured(d) {
+		configured := configuredCustomFieldNames(d)
+		values := make(map[string]interface{}, len(cf))
+		for name, value := range cf {
+			if _, isDefault := s.defaultCustomFields[name]; (isDefault || s.customFieldIgnored(name)) && !configured[name] {
+				continue
+			}
+			switch value.(type) {
+			case []interface{}, map[string]interface{}:
+				// the string map can only hold JSON for complex values
+				if encoded, err := json.Marshal(value); err == nil {
+					value = string(encoded)
+				}
+			}
+			values[name] = value
+		}
+		if cf != nil {
+			d.Set(customFieldsKey, values)
+		}
+		return
+	}
+
+	configured := decodeCustomFieldsJSON(d.Get(customFieldsJSONKey))
+	values := make(map[string]interface{}, len(cf))
+	for name, value := range cf {
+		_, isConfigured := configured[name]
+		_, isDefault := s.defaultCustomFields[name]
+		if isConfigured || (value != nil && !isDefault && !s.customFieldIgnored(name)) {
+			values[name] = value
+		}
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return
+	}
+	d.Set(customFieldsJSONKey, string(encoded))
+	d.Set(customFieldsKey, nil)
+}
+
+// configuredCustomFieldNames returns the names of the custom fields configured
+// in `custom_fields`.
+func configuredCustomFieldNames(d *schema.ResourceData) map[string]bool {
+	names := make(map[string]bool)
+	for _, raw := range []cty.Value{d.GetRawConfig(), d.GetRawState()} { // config is missing during refresh
+		if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(customFieldsKey) {
+			continue
+		}
+		v := raw.GetAttr(customFieldsKey)
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		for name := range v.AsValueMap() {
+			names[name] = true
+		}
+		return names
+	}
+	return names
+}
+
+// customFieldIgnored reports whether the given custom field is managed outside
+// of Terraform, see the `ignore_custom_fields` provider attribute.
+func (s *providerState) customFieldIgnored(name string) bool {
+	return slices.Contains(s.ignoreCustomFields, name)
+}
+
+// customFieldsJSONConfigured reports whether the custom fields of a resource
+// are managed via `custom_fields_json`.
+func customFieldsJSONConfigured(d *schema.ResourceData) bool {
+	for _, raw := range []cty.Value{d.GetRawConfig(), d.GetRawState()} { // config is missing during refresh
+		if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(customFieldsJSONKey) {
+			continue
+		}
+		if v := raw.GetAttr(customFieldsJSONKey); !v.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenCustomFieldValue replaces the nested objects Netbox returns for
+// object and multi-object custom fields with their IDs.
+func flattenCustomFieldValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if isNestedObject(v) {
+			return v["id"]
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return v
+		}
+		ids := make([]interface{}, len(v))
+		for i, item := range v {
+			object, ok := item.(map[string]interface{})
+			if !ok || !isNestedObject(object) {
+				return v
+			}
+			ids[i] = object["id"]
+		}
+		return ids
+	}
+	return value
+}
+
+// isNestedObject reports whether the given value is a brief representation of
+// a Netbox object, as opposed to the value of a JSON custom field.
+func isNestedObject(v map[string]interface{}) bool {
+	for _, key := range []string{"id", "url", "display"} {
+		if _, ok := v[key]; !ok {
+			return false
+		}
+	}
+	_, ok := v["url"].(string)
+	return ok
+}
+
+// customFieldStrings converts custom field values to the strings stored in
+// `custom_fields_all`. Unset custom fields are left out.
+func customFieldStrings(cf map[string]interface{}) map[string]interface{} {
+	values := make(map[string]interface{}, len(cf))
+	for name, value := range cf {
+		if value == nil {
+			continue
+		}
+		values[name] = customFieldString(value)
+	}
+	return values
+}
+
+func customFieldString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
+
+// customFieldsCustomDiff plans `custom_fields_all`: the default custom fields
+// of the provider merged with the custom fields of the resource. Ignored custom
+// fields keep their current values.
+func customFieldsCustomDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	state := m.(*providerState)
+
+	if !diff.NewValueKnown(customFieldsKey) || !diff.NewValueKnown(customFieldsJSONKey) {
+		return diff.SetNewComputed(customFieldsAllKey)
+	}
+
+	allCustomFields := make(map[string]interface{})
+	oldCustomFields, _ := diff.GetChange(customFieldsAllKey)
+	for name, value := range getCustomFields(oldCustomFields) {
+		if state.customFieldIgnored(name) {
+			allCustomFields[name] = value
+		}
+	}
+	for name, value := range state.defaultCustomFields {
+		allCustomFields[name] = value
+	}
+	for name, value := range decodeCustomFieldsJSON(diff.Get(customFieldsJSONKey)) {
+		if value == nil {
+			delete(allCustomFields, name)
+		} else {
+			allCustomFields[name] = customFieldString(value)
+		}
+	}
+	for name, value := range getCustomFields(diff.Get(customFieldsKey)) {
+		allCustomFields[name] = value
+	}
+
+	// check if custom fields are already up-to-date
+	current, _ := diff.Get(customFieldsAllKey).(map[string]interface{})
+	if len(current) == len(allCustomFields) && (len(current) == 0 || reflect.DeepEqual(current, allCustomFields)) {
+		return nil
+	}
+
+	return diff.SetNew(customFieldsAllKey, allCustomFields)
+}
@@ -0,0 +1,254 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCustomFieldsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			customFieldsAllKey:  customFieldsAllSchema,
+		},
+	}
+}
+
+// testCustomFieldsResourceDataRaw is like schema.TestResourceDataRaw, but
+// also sets the raw configuration, as Terraform does.
+func testCustomFieldsResourceDataRaw(t *testing.T, customFields map[string]string, customFieldsJSON string) *schema.ResourceData {
+	raw := make(map[string]interface{})
+	rawConfig := map[string]cty.Value{
+		customFieldsKey:     cty.NullVal(cty.Map(cty.String)),
+		customFieldsJSONKey: cty.NullVal(cty.String),
+		customFieldsAllKey:  cty.NullVal(cty.Map(cty.String)),
+	}
+	if customFields != nil {
+		values := make(map[string]interface{})
+		rawValues := make(map[string]cty.Value)
+		for name, value := range customFields {
+			values[name] = value
+			rawValues[name] = cty.StringVal(value)
+		}
+		raw[customFieldsKey] = values
+		rawConfig[customFieldsKey] = cty.MapVal(rawValues)
+	}
+	if customFieldsJSON != "" {
+		raw[customFieldsJSONKey] = customFieldsJSON
+		rawConfig[customFieldsJSONKey] = cty.StringVal(customFieldsJSON)
+	}
+
+	sm := schema.InternalMap(testCustomFieldsResource().Schema)
+	diff, err := sm.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	assert.NoError(t, err)
+	diff.RawConfig = cty.ObjectVal(rawConfig)
+	d, err := sm.Data(nil, diff)
+	assert.NoError(t, err)
+	return d
+}
+
+func TestFlattenCustomFieldValue(t *testing.T) {
+	tenant := map[string]interface{}{
+		"id":      json.Number("3"),
+		"url":     "http://localhost/api/tenancy/tenants/3/",
+		"display": "Tenant 3",
+		"name":    "Tenant 3",
+	}
+
+	for _, tc := range []struct {
+		value    interface{}
+		expected interface{}
+	}{
+		{"text", "text"},
+		{json.Number("42"), json.Number("42")},
+		{true, true},
+		{nil, nil},
+		{[]interface{}{"a", "b"}, []interface{}{"a", "b"}},
+		{map[string]interface{}{"id": json.Number("1")}, map[string]interface{}{"id": json.Number("1")}},
+		{tenant, json.Number("3")},
+		{[]interface{}{tenant, tenant}, []interface{}{json.Number("3"), json.Number("3")}},
+		{[]interface{}{}, []interface{}{}},
+	} {
+		assert.Equal(t, tc.expected, flattenCustomFieldValue(tc.value))
+	}
+}
+
+func TestCustomFieldsFromResourceData(t *testing.T) {
+	r := testCustomFieldsResource()
+	var api providerState
+
+	d := r.TestResourceData()
+	assert.Nil(t, api.customFieldsFromResourceData(d))
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		customFieldsJSONKey: `{"count": 4, "active": false, "owners": [1, 2]}`,
+	})
+	assert.Equal(t, map[string]interface{}{
+		"count":  float64(4),
+		"active": false,
+		"owners": []interface{}{float64(1), float64(2)},
+	}, api.customFieldsFromResourceData(d))
+
+	// custom fields removed from the configuration are cleared
+	state := &terraform.InstanceState{
+		ID: "1",
+		Attributes: map[string]string{
+			customFieldsJSONKey: `{"count": 4, "active": false}`,
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		customFieldsJSONKey: `{"count": 5}`,
+	})
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), state, config, nil, nil, false)
+	assert.NoError(t, err)
+	d, err = schema.InternalMap(r.Schema).Data(state, diff)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"count":  float64(5),
+		"active": nil,
+	}, api.customFieldsFromResourceData(d))
+}
+
+func TestReadCustomFieldsString(t *testing.T) {
+	d := testCustomFieldsResource().TestResourceData()
+
+	var state providerState
+	state.readCustomFields(d, map[string]interface{}{
+		"text":   "foo",
+		"number": json.Number("42"),
+		"list":   []interface{}{"a", "b"},
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"text":   "foo",
+		"number": "42",
+		"list":   `["a","b"]`,
+	}, d.Get(customFieldsKey))
+	assert.Equal(t, "", d.Get(customFieldsJSONKey))
+}
+
+func TestCustomFieldsFromResourceDataDefaults(t *testing.T) {
+	r := testCustomFieldsResource()
+	state := providerState{defaultCustomFields: map[string]interface{}{
+		"owner_team":  "network",
+		"cost_center": "4711",
+	}}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		customFieldsKey: map[string]interface{}{"owner_team": "storage"},
+	})
+	assert.Equal(t, map[string]interface{}{
+		"owner_team":  "storage",
+		"cost_center": "4711",
+	}, state.customFieldsFromResourceData(d))
+
+	// default custom fields removed from the provider are cleared
+	stateFile := &terraform.InstanceState{
+		ID: "1",
+		Attributes: map[string]string{
+			customFieldsAllKey + ".%":          "2",
+			customFieldsAllKey + ".owner_team": "network",
+			customFieldsAllKey + ".legacy":     "yes",
+		},
+	}
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), stateFile, terraform.NewResourceConfigRaw(nil), nil, nil, false)
+	assert.NoError(t, err)
+	d, err = schema.InternalMap(r.Schema).Data(stateFile, diff)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"owner_team":  "network",
+		"cost_center": "4711",
+		"legacy":      nil,
+	}, state.customFieldsFromResourceData(d))
+}
+
+func TestReadCustomFieldsDefaults(t *testing.T) {
+	state := providerState{defaultCustomFields: map[string]interface{}{
+		"owner_team":  "network",
+		"cost_center": "4711",
+	}}
+	apiCustomFields := map[string]interface{}{
+		"owner_team":  "network",
+		"cost_center": "4711",
+		"rack_count":  json.Number("4"),
+		"unset":       nil,
+	}
+
+	d := testCustomFieldsResourceDataRaw(t, map[string]string{"cost_center": "4711", "rack_count": "4"}, "")
+	state.readCustomFields(d, apiCustomFields)
+	assert.Equal(t, map[string]interface{}{
+		"cost_center": "4711",
+		"rack_count":  "4",
+		"unset":       "",
+	}, d.Get(customFieldsKey))
+	assert.Equal(t, map[string]interface{}{
+		"owner_team":  "network",
+		"cost_center": "4711",
+		"rack_count":  "4",
+	}, d.Get(customFieldsAllKey))
+
+	d = testCustomFieldsResourceDataRaw(t, nil, `{"rack_count": 4}`)
+	state.readCustomFields(d, apiCustomFields)
+	assert.Equal(t, `{"rack_count":4}`, d.Get(customFieldsJSONKey))
+}
+
+func TestCustomFieldString(t *testing.T) {
+	for _, tc := range []struct {
+		value    interface{}
+		expected string
+	}{
+		{"text", "text"},
+		{json.Number("4"), "4"},
+		{float64(4), "4"},
+		{1.5, "1.5"},
+		{true, "true"},
+		{[]interface{}{json.Number("1"), json.Number("2")}, "[1,2]"},
+		{[]interface{}{float64(1), float64(2)}, "[1,2]"},
+		{map[string]interface{}{"a": "b"}, `{"a":"b"}`},
+	} {
+		assert.Equal(t, tc.expected, customFieldString(tc.value))
+	}
+}
+
+func TestReadCustomFieldsIgnored(t *testing.T) {
+	state := providerState{ignoreCustomFields: []string{"discovered_at"}}
+	apiCustomFields := map[string]interface{}{
+		"discovered_at": "2026-10-17",
+		"rack_count":    json.Number("4"),
+	}
+
+	d := testCustomFieldsResourceDataRaw(t, map[string]string{"rack_count": "4"}, "")
+	state.readCustomFields(d, apiCustomFields)
+	assert.Equal(t, map[string]interface{}{"rack_count": "4"}, d.Get(customFieldsKey))
+	assert.Equal(t, map[string]interface{}{
+		"discovered_at": "2026-10-17",
+		"rack_count":    "4",
+	}, d.Get(customFieldsAllKey))
+
+	d = testCustomFieldsResourceDataRaw(t, nil, `{"rack_count": 4}`)
+	state.readCustomFields(d, apiCustomFields)
+	assert.Equal(t, `{"rack_count":4}`, d.Get(customFieldsJSONKey))
+
+	// ignored custom fields are not cleared on update
+	stateFile := &terraform.InstanceState{
+		ID: "1",
+		Attributes: map[string]string{
+			customFieldsAllKey + ".%":             "2",
+			customFieldsAllKey + ".discovered_at": "2026-10-17",
+			customFieldsAllKey + ".rack_count":    "4",
+		},
+	}
+	r := testCustomFieldsResource()
+	diff, err := schema.InternalMap(r.Schema).Diff(context.Background(), stateFile, terraform.NewResourceConfigRaw(nil), nil, nil, false)
+	assert.NoError(t, err)
+	d, err = schema.InternalMap(r.Schema).Data(stateFile, diff)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"rack_count": nil}, state.customFieldsFromResourceData(d))
+}
@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxAsn() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxAsnRead,
+		ReadContext: dataSourceNetboxAsnRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -44,10 +45,10 @@ for more information on available lookup expressions.`,
 	}
 }
 
-func dataSourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxAsnRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := ipam.NewIpamAsnsListParams()
+	params := ipam.NewIpamAsnsListParams().WithContext(ctx)
 
 	limit := int64(2) // Limit of 2 is enough
 	params.Limit = &limit
@@ -65,14 +66,14 @@ func dataSourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
 
 	res, err := api.Ipam.IpamAsnsList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one asn returned, specify a more narrow filter")
+		return diag.Errorf("more than one asn returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no asn found matching filter")
+		return diag.Errorf("no asn found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.Set("id", result.ID)
@@ -0,0 +1,117 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxAsnRange() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxAsnRangeRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name", "slug"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name", "slug"},
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name", "slug"},
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"start": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"end": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asn_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxAsnRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	query := url.Values{"limit": {"2"}} // Limit of 2 is enough
+	if id, ok := d.GetOk("id"); ok {
+		query.Set("id", strconv.Itoa(id.(int)))
+	}
+	if name, ok := d.GetOk("name"); ok {
+		query.Set("name", name.(string))
+	}
+	if slug, ok := d.GetOk("slug"); ok {
+		query.Set("slug", slug.(string))
+	}
+	if rirID, ok := d.GetOk("rir_id"); ok {
+		query.Set("rir_id", strconv.Itoa(rirID.(int)))
+	}
+
+	var res struct {
+		Count   int64             `json:"count"`
+		Results []*netboxASNRange `json:"results"`
+	}
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, asnRangesPath, query, nil, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if res.Count > int64(1) {
+		return diag.Errorf("more than one asn range returned, specify a more narrow filter")
+	}
+	if res.Count == int64(0) {
+		return diag.Errorf("no asn range found matching filter")
+	}
+
+	result := res.Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+	d.Set("start", result.Start)
+	d.Set("end", result.End)
+	d.Set("description", result.Description)
+	d.Set("asn_count", result.AsnCount)
+	if result.Rir != nil {
+		d.Set("rir_id", result.Rir.ID)
+	}
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
@@ -0,0 +1,48 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAsnRangeDataSource_basic(t *testing.T) {
+	testSlug := "asn_range_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name       = "%[1]s"
+  is_private = true
+}
+
+resource "netbox_asn_range" "test" {
+  name   = "%[1]s"
+  rir_id = netbox_rir.test.id
+  start  = 4200001100
+  end    = 4200001199
+}
+
+data "netbox_asn_range" "by_name" {
+  name = netbox_asn_range.test.name
+}
+
+data "netbox_asn_range" "by_slug" {
+  slug = netbox_asn_range.test.slug
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_asn_range.by_name", "id", "netbox_asn_range.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_asn_range.by_name", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_asn_range.by_name", "start", "4200001100"),
+					resource.TestCheckResourceAttr("data.netbox_asn_range.by_name", "end", "4200001199"),
+					resource.TestCheckResourceAttrPair("data.netbox_asn_range.by_slug", "id", "netbox_asn_range.test", "id"),
+				),
+			},
+		},
+	})
+}
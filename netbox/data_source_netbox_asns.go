@@ -1,10 +1,11 @@
 package netbox
 
 import (
-	"errors"
-	"fmt"
+	"context"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,25 +13,10 @@ import (
 
 func dataSourceNetboxAsns() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxAsnsRead,
+		ReadContext: dataSourceNetboxAsnsRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"filter": filterSchema("ASNs"),
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
@@ -62,46 +48,34 @@ func dataSourceNetboxAsns() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxAsnsRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxAsnsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := ipam.NewIpamAsnsListParams()
+	params := ipam.NewIpamAsnsListParams().WithContext(ctx)
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	filters, err := filterQueryParams(d, nil)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "asn":
-				params.Asn = &vString
-			case "asn__gte":
-				params.AsnGte = &vString
-			case "asn__lte":
-				params.AsnLte = &vString
-			case "asn__n":
-				params.Asnn = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.ASN, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Ipam.IpamAsnsList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
 		}
-	}
-
-	res, err := api.Ipam.IpamAsnsList(params, nil)
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+	if len(results) == 0 {
+		return diag.Errorf("no result")
 	}
 
-	filteredAsns := res.GetPayload().Results
+	filteredAsns := results
 
 	var s []map[string]interface{}
 	for _, v := range filteredAsns {
@@ -116,5 +90,5 @@ func dataSourceNetboxAsnsRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("asns", s)
+	return diag.FromErr(d.Set("asns", s))
 }
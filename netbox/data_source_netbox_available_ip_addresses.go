@@ -0,0 +1,132 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxAvailableIPAddresses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxAvailableIPAddressesRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):This data source lists the available IP addresses of a prefix or IP range without allocating them. Use ` + "`netbox_available_ip_address`" + ` to allocate an IP address.`,
+		Schema: map[string]*schema.Schema{
+			"prefix_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
+			},
+			"ip_range_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "The maximum number of available IP addresses to return.",
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"family": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vrf_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"include_utilization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, `utilization` is calculated. This requires additional requests, which can be slow for large prefixes.",
+			},
+			"utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The utilization of the prefix or IP range in percent, as shown by Netbox. Only set if `include_utilization` is set.",
+			},
+		},
+	}
+}
+
+func dataSourceNetboxAvailableIPAddressesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	includeUtilization := d.Get("include_utilization").(bool)
+
+	var path string
+	var utilization float64
+	if prefixID, ok := d.GetOk("prefix_id"); ok {
+		if includeUtilization {
+			params := ipam.NewIpamPrefixesReadParams().WithContext(ctx).WithID(int64(prefixID.(int)))
+			res, err := api.Ipam.IpamPrefixesRead(params, nil)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			utilization, err = prefixUtilization(ctx, api, res.GetPayload())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		path = fmt.Sprintf("/ipam/prefixes/%d/available-ips/", prefixID)
+	} else {
+		rangeID := d.Get("ip_range_id").(int)
+		if includeUtilization {
+			var err error
+			_, utilization, err = ipRangeUtilization(ctx, api, int64(rangeID))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		path = fmt.Sprintf("/ipam/ip-ranges/%d/available-ips/", rangeID)
+	}
+
+	// the generated client can not limit the number of available IP addresses
+	var result []*models.AvailableIP
+	query := url.Values{"limit": {strconv.Itoa(d.Get("limit").(int))}}
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, path, query, nil, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var s []map[string]interface{}
+	for _, v := range result {
+		var mapping = make(map[string]interface{})
+
+		mapping["address"] = v.Address
+		mapping["family"] = v.Family
+		if v.Vrf != nil {
+			mapping["vrf_id"] = v.Vrf.ID
+		}
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	if includeUtilization {
+		d.Set("utilization", utilization)
+	}
+	return diag.FromErr(d.Set("ip_addresses", s))
+}
@@ -0,0 +1,62 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAvailableIPAddressesDataSource_basic(t *testing.T) {
+	testSlug := "available_ips_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_prefix" "test" {
+  prefix = "10.10.30.0/29"
+  status = "active"
+  vrf_id = netbox_vrf.test.id
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address = "10.10.30.1/29"
+  status     = "active"
+  vrf_id     = netbox_vrf.test.id
+}
+
+resource "netbox_ip_range" "test" {
+  start_address = "10.10.30.2/29"
+  end_address   = "10.10.30.5/29"
+  vrf_id        = netbox_vrf.test.id
+}
+
+data "netbox_available_ip_addresses" "prefix" {
+  prefix_id  = netbox_prefix.test.id
+  limit      = 2
+  depends_on = [netbox_ip_address.test]
+}
+
+data "netbox_available_ip_addresses" "range" {
+  ip_range_id         = netbox_ip_range.test.id
+  include_utilization = true
+  depends_on          = [netbox_ip_address.test]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.prefix", "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.prefix", "ip_addresses.0.address", "10.10.30.2/29"),
+					resource.TestCheckResourceAttrPair("data.netbox_available_ip_addresses.prefix", "ip_addresses.0.vrf_id", "netbox_vrf.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.range", "ip_addresses.#", "4"),
+					resource.TestCheckNoResourceAttr("data.netbox_available_ip_addresses.prefix", "utilization"),
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.range", "utilization", "0"),
+				),
+			},
+		},
+	})
+}
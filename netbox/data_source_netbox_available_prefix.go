@@ -1,14 +1,17 @@
 package netbox
 
 import (
+	"context"
+
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxAvailablePrefix() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxAvailablePrefixRead,
+		ReadContext: dataSourceNetboxAvailablePrefixRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
 			"prefix_id": {
@@ -39,10 +42,10 @@ func dataSourceNetboxAvailablePrefix() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxAvailablePrefixRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxAvailablePrefixRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := ipam.NewIpamPrefixesAvailablePrefixesListParams()
+	params := ipam.NewIpamPrefixesAvailablePrefixesListParams().WithContext(ctx)
 
 	if prefixID, ok := d.Get("prefix_id").(int); ok && prefixID != 0 {
 		params.ID = int64(prefixID)
@@ -50,7 +53,7 @@ func dataSourceNetboxAvailablePrefixRead(d *schema.ResourceData, m interface{})
 
 	res, err := api.Ipam.IpamPrefixesAvailablePrefixesList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	result := res.GetPayload()
@@ -69,5 +72,5 @@ func dataSourceNetboxAvailablePrefixRead(d *schema.ResourceData, m interface{})
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("prefixes_available", s)
+	return diag.FromErr(d.Set("prefixes_available", s))
 }
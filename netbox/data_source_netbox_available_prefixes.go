@@ -0,0 +1,124 @@
+package netbox
+
+import (
+	"context"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxAvailablePrefixes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxAvailablePrefixesRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):This data source lists the available child prefixes of a prefix without allocating them. Use ` + "`netbox_available_prefix`" + ` to allocate a prefix.`,
+		Schema: map[string]*schema.Schema{
+			"prefix_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+				Description:  "If set, the available space is split into prefixes of this length, in the order Netbox would allocate them. Available prefixes that are too small are skipped.",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of available prefixes to return.",
+			},
+			"prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"family": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vrf_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"include_utilization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, `utilization` is calculated. This requires additional requests, which can be slow for large prefixes.",
+			},
+			"utilization": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The utilization of the prefix in percent, as shown by Netbox. Only set if `include_utilization` is set.",
+			},
+		},
+	}
+}
+
+func dataSourceNetboxAvailablePrefixesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	prefixID := int64(d.Get("prefix_id").(int))
+
+	includeUtilization := d.Get("include_utilization").(bool)
+
+	var utilization float64
+	if includeUtilization {
+		prefixParams := ipam.NewIpamPrefixesReadParams().WithContext(ctx).WithID(prefixID)
+		prefixRes, err := api.Ipam.IpamPrefixesRead(prefixParams, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		utilization, err = prefixUtilization(ctx, api, prefixRes.GetPayload())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	params := ipam.NewIpamPrefixesAvailablePrefixesListParams().WithContext(ctx).WithID(prefixID)
+	res, err := api.Ipam.IpamPrefixesAvailablePrefixesList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	limit := d.Get("limit").(int)
+	result := res.GetPayload()
+	if length, ok := d.GetOk("prefix_length"); ok {
+		result, err = availablePrefixesOfLength(result, length.(int), limit)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if len(result) > limit {
+		result = result[:limit]
+	}
+
+	var s []map[string]interface{}
+	for _, v := range result {
+		var mapping = make(map[string]interface{})
+
+		mapping["prefix"] = v.Prefix
+		mapping["family"] = v.Family
+		if v.Vrf != nil {
+			mapping["vrf_id"] = v.Vrf.ID
+		}
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	if includeUtilization {
+		d.Set("utilization", utilization)
+	}
+	return diag.FromErr(d.Set("prefixes", s))
+}
@@ -0,0 +1,60 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAvailablePrefixesListDataSource_basic(t *testing.T) {
+	testSlug := "available_prefixes_list_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_prefix" "parent" {
+  prefix = "10.10.20.0/24"
+  status = "container"
+  vrf_id = netbox_vrf.test.id
+}
+
+resource "netbox_prefix" "child" {
+  prefix = "10.10.20.0/26"
+  status = "active"
+  vrf_id = netbox_vrf.test.id
+}
+
+data "netbox_available_prefixes" "all" {
+  prefix_id           = netbox_prefix.parent.id
+  include_utilization = true
+  depends_on          = [netbox_prefix.child]
+}
+
+data "netbox_available_prefixes" "by_length" {
+  prefix_id     = netbox_prefix.parent.id
+  prefix_length = 28
+  limit         = 3
+  depends_on    = [netbox_prefix.child]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.all", "prefixes.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.all", "prefixes.0.prefix", "10.10.20.64/26"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.all", "prefixes.1.prefix", "10.10.20.128/25"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.all", "utilization", "25"),
+					resource.TestCheckNoResourceAttr("data.netbox_available_prefixes.by_length", "utilization"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.by_length", "prefixes.#", "3"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.by_length", "prefixes.0.prefix", "10.10.20.64/28"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.by_length", "prefixes.2.prefix", "10.10.20.96/28"),
+					resource.TestCheckResourceAttrPair("data.netbox_available_prefixes.by_length", "prefixes.0.vrf_id", "netbox_vrf.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -1,17 +1,18 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxCluster() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxClusterRead,
+		ReadContext: dataSourceNetboxClusterRead,
 		Description: `:meta:subcategory:Virtualization:`,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -81,10 +82,10 @@ func dataSourceNetboxCluster() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := virtualization.NewVirtualizationClustersListParams()
+	params := virtualization.NewVirtualizationClustersListParams().WithContext(ctx)
 
 	if name, ok := d.Get("name").(string); ok && name != "" {
 		params.Name = &name
@@ -108,14 +109,14 @@ func dataSourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
 
 	res, err := api.Virtualization.VirtualizationClustersList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one result, specify a more narrow filter")
+		return diag.Errorf("more than one result, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+		return diag.Errorf("no result")
 	}
 	result := res.GetPayload().Results[0]
 	d.Set("cluster_id", result.ID)
@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxClusterGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxClusterGroupRead,
+		ReadContext: dataSourceNetboxClusterGroupRead,
 		Description: `:meta:subcategory:Virtualization:`,
 		Schema: map[string]*schema.Schema{
 			"cluster_group_id": {
@@ -25,25 +26,25 @@ func dataSourceNetboxClusterGroup() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxClusterGroupRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxClusterGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
-	params := virtualization.NewVirtualizationClusterGroupsListParams()
+	params := virtualization.NewVirtualizationClusterGroupsListParams().WithContext(ctx)
 	params.Name = &name
 	limit := int64(2) // Limit of 2 is enough
 	params.Limit = &limit
 
 	res, err := api.Virtualization.VirtualizationClusterGroupsList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one cluster group returned, specify a more narrow filter")
+		return diag.Errorf("more than one cluster group returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no cluster group found matching filter")
+		return diag.Errorf("no cluster group found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.Set("cluster_group_id", result.ID)
@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxClusterType() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxClusterTypeRead,
+		ReadContext: dataSourceNetboxClusterTypeRead,
 		Description: `:meta:subcategory:Virtualization:`,
 		Schema: map[string]*schema.Schema{
 			"cluster_type_id": {
@@ -25,25 +26,25 @@ func dataSourceNetboxClusterType() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxClusterTypeRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxClusterTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
-	params := virtualization.NewVirtualizationClusterTypesListParams()
+	params := virtualization.NewVirtualizationClusterTypesListParams().WithContext(ctx)
 	params.Name = &name
 	limit := int64(2) // Limit of 2 is enough
 	params.Limit = &limit
 
 	res, err := api.Virtualization.VirtualizationClusterTypesList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one cluster type returned, specify a more narrow filter")
+		return diag.Errorf("more than one cluster type returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no cluster type found matching filter")
+		return diag.Errorf("no cluster type found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.Set("cluster_type_id", result.ID)
@@ -1,17 +1,18 @@
 package netbox
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxConfigContext() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxConfigContextRead,
+		ReadContext: dataSourceNetboxConfigContextRead,
 		Description: `:meta:subcategory:Extras:`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -138,25 +139,25 @@ func dataSourceNetboxConfigContext() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxConfigContextRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxConfigContextRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
-	params := extras.NewExtrasConfigContextsListParams()
+	params := extras.NewExtrasConfigContextsListParams().WithContext(ctx)
 	params.Name = &name
 	limit := int64(2) // Limit of 2 is enough
 	params.Limit = &limit
 
 	res, err := api.Extras.ExtrasConfigContextsList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one result. Specify a more narrow filter")
+		return diag.Errorf("more than one result. Specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+		return diag.Errorf("no result")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxContact() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxContactRead,
+		ReadContext: dataSourceNetboxContactRead,
 		Description: `:meta:subcategory:Tenancy:`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -37,9 +38,9 @@ func dataSourceNetboxContact() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxContactRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-	params := tenancy.NewTenancyContactsListParams()
+	params := tenancy.NewTenancyContactsListParams().WithContext(ctx)
 
 	if name, ok := d.Get("name").(string); ok && name != "" {
 		params.Name = &name
@@ -50,14 +51,14 @@ func dataSourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
 
 	res, err := api.Tenancy.TenancyContactsList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one contact returned, specify a more narrow filter")
+		return diag.Errorf("more than one contact returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no contact found matching filter")
+		return diag.Errorf("no contact found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxContactGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxContactGroupRead,
+		ReadContext: dataSourceNetboxContactGroupRead,
 		Description: `:meta:subcategory:Tenancy:`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -33,25 +34,25 @@ func dataSourceNetboxContactGroup() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxContactGroupRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxContactGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
-	params := tenancy.NewTenancyContactGroupsListParams()
+	params := tenancy.NewTenancyContactGroupsListParams().WithContext(ctx)
 	params.Name = &name
 	limit := int64(2) // Limit of 2 is enough
 	params.Limit = &limit
 
 	res, err := api.Tenancy.TenancyContactGroupsList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one contact group returned, specify a more narrow filter")
+		return diag.Errorf("more than one contact group returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no contact group found matching filter")
+		return diag.Errorf("no contact group found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
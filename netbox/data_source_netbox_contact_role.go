@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxContactRole() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxContactRoleRead,
+		ReadContext: dataSourceNetboxContactRoleRead,
 		Description: `:meta:subcategory:Tenancy:`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -29,9 +30,9 @@ func dataSourceNetboxContactRole() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxContactRoleRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxContactRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-	params := tenancy.NewTenancyContactRolesListParams()
+	params := tenancy.NewTenancyContactRolesListParams().WithContext(ctx)
 
 	if name, ok := d.Get("name").(string); ok && name != "" {
 		params.Name = &name
@@ -42,14 +43,14 @@ func dataSourceNetboxContactRoleRead(d *schema.ResourceData, m interface{}) erro
 
 	res, err := api.Tenancy.TenancyContactRolesList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one contact role returned, specify a more narrow filter")
+		return diag.Errorf("more than one contact role returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no contact role found matching filter")
+		return diag.Errorf("no contact role found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -1,12 +1,12 @@
 package netbox
 
 import (
-	"errors"
-	"fmt"
+	"context"
 	"regexp"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,25 +14,10 @@ import (
 
 func dataSourceNetboxDeviceInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxDeviceInterfaceRead,
+		ReadContext: dataSourceNetboxDeviceInterfaceRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"filter": filterSchema("interfaces"),
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -159,56 +144,43 @@ func dataSourceNetboxDeviceInterfaces() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxDeviceInterfaceRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := dcim.NewDcimInterfacesListParams()
+	params := dcim.NewDcimInterfacesListParams().WithContext(ctx)
 
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	filters, err := filterQueryParams(d, nil)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "mac_address":
-				params.MacAddress = &vString
-			case "name":
-				params.Name = &vString
-			case "tag":
-				params.Tag = []string{vString} //TODO: switch schema to list?
-			case "device_id":
-				params.DeviceID = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.Interface, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Dcim.DcimInterfacesList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
 		}
-	}
-
-	res, err := api.Dcim.DcimInterfacesList(params, nil)
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+	if len(results) == 0 {
+		return diag.Errorf("no result")
 	}
 
 	var filteredInterfaces []*models.Interface
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		r := regexp.MustCompile(nameRegex.(string))
-		for _, dcimInterface := range res.GetPayload().Results {
+		for _, dcimInterface := range results {
 			if r.MatchString(*dcimInterface.Name) {
 				filteredInterfaces = append(filteredInterfaces, dcimInterface)
 			}
 		}
 	} else {
-		filteredInterfaces = res.GetPayload().Results
+		filteredInterfaces = results
 	}
 
 	var s []map[string]interface{}
@@ -267,7 +239,7 @@ func dataSourceNetboxDeviceInterfaceRead(d *schema.ResourceData, m interface{})
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("interfaces", s)
+	return diag.FromErr(d.Set("interfaces", s))
 }
 
 /*
@@ -1,12 +1,13 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"regexp"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,25 +15,10 @@ import (
 
 func dataSourceNetboxDevicePowerPorts() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxDevicePowerPortRead,
+		ReadContext: dataSourceNetboxDevicePowerPortRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"filter": filterSchema("power ports"),
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -97,57 +83,46 @@ func dataSourceNetboxDevicePowerPorts() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxDevicePowerPortRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxDevicePowerPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := dcim.NewDcimPowerPortsListParams()
+	params := dcim.NewDcimPowerPortsListParams().WithContext(ctx)
 
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	filters, err := filterQueryParams(d, nil)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "name":
-				params.Name = &vString
-			case "tag":
-				params.Tag = []string{vString} //TODO: switch schema to list?
-			case "device_id":
-				params.DeviceID = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.PowerPort, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Dcim.DcimPowerPortsList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
 		}
-	}
-
-	res, err := api.Dcim.DcimPowerPortsList(params, nil)
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+	if len(results) == 0 {
+		return diag.Errorf("no result")
 	}
 
 	var filteredInterfaces []*models.PowerPort
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		r, err := regexp.Compile(nameRegex.(string))
 		if err != nil {
-			return fmt.Errorf("failed to compile name regex: %w", err)
+			return diag.FromErr(fmt.Errorf("failed to compile name regex: %w", err))
 		}
-		for _, port := range res.GetPayload().Results {
+		for _, port := range results {
 			if r.MatchString(*port.Name) {
 				filteredInterfaces = append(filteredInterfaces, port)
 			}
 		}
 	} else {
-		filteredInterfaces = res.GetPayload().Results
+		filteredInterfaces = results
 	}
 
 	var s []map[string]interface{}
@@ -188,5 +163,5 @@ func dataSourceNetboxDevicePowerPortRead(d *schema.ResourceData, m interface{})
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("power_ports", s)
+	return diag.FromErr(d.Set("power_ports", s))
 }
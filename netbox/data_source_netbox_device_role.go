@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxDeviceRole() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxDeviceRoleRead,
+		ReadContext: dataSourceNetboxDeviceRoleRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -30,25 +31,25 @@ func dataSourceNetboxDeviceRole() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxDeviceRoleRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxDeviceRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
-	params := dcim.NewDcimDeviceRolesListParams()
+	params := dcim.NewDcimDeviceRolesListParams().WithContext(ctx)
 	params.Name = &name
 	limit := int64(2) // Limit of 2 is enough
 	params.Limit = &limit
 
 	res, err := api.Dcim.DcimDeviceRolesList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one device role returned, specify a more narrow filter")
+		return diag.Errorf("more than one device role returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no device role found matching filter")
+		return diag.Errorf("no device role found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
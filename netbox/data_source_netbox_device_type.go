@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxDeviceType() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxDeviceTypeRead,
+		ReadContext: dataSourceNetboxDeviceTypeRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
 			"is_full_depth": {
@@ -49,9 +50,9 @@ func dataSourceNetboxDeviceType() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxDeviceTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-	params := dcim.NewDcimDeviceTypesListParams()
+	params := dcim.NewDcimDeviceTypesListParams().WithContext(ctx)
 
 	params.Limit = int64ToPtr(2)
 	if manufacturer, ok := d.Get("manufacturer").(string); ok && manufacturer != "" {
@@ -69,14 +70,14 @@ func dataSourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error
 
 	res, err := api.Dcim.DcimDeviceTypesList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one device type returned, specify a more narrow filter")
+		return diag.Errorf("more than one device type returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no device type found matching filter")
+		return diag.Errorf("no device type found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -4,14 +4,15 @@
 package netbox
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net"
 	"regexp"
 	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,25 +20,10 @@ import (
 
 func dataSourceNetboxDevices() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxDevicesRead,
+		ReadContext: dataSourceNetboxDevicesRead,
 		Description: ":meta:subcategory:Data Center Inventory Management (DCIM):",
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"filter": filterSchema("devices"),
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -160,79 +146,43 @@ func dataSourceNetboxDevices() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxDevicesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxDevicesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := dcim.NewDcimDevicesListParams()
+	params := dcim.NewDcimDevicesListParams().WithContext(ctx)
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			switch k {
-			case "asset_tag":
-				var assetTagString = v.(string)
-				params.AssetTag = &assetTagString
-			case "cluster_id":
-				var clusterString = v.(string)
-				params.ClusterID = &clusterString
-			case "device_type_id":
-				var deviceTypeIDString = v.(string)
-				params.DeviceTypeID = &deviceTypeIDString
-			case "name":
-				var nameString = v.(string)
-				params.Name = &nameString
-			case "region":
-				var regionString = v.(string)
-				params.Region = &regionString
-			case "role_id":
-				var roleIDString = v.(string)
-				params.RoleID = &roleIDString
-			case "site_id":
-				var siteIDString = v.(string)
-				params.SiteID = &siteIDString
-			case "location_id":
-				var locationIDString = v.(string)
-				params.LocationID = &locationIDString
-			case "rack_id":
-				var rackIDString = v.(string)
-				params.RackID = &rackIDString
-			case "tenant_id":
-				var tenantIDString = v.(string)
-				params.TenantID = &tenantIDString
-			case "tags":
-				var tagsString = v.(string)
-				params.Tag = strings.Split(tagsString, ",")
-			case "status":
-				var statusString = v.(string)
-				params.Status = &statusString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
-		}
+	filters, err := filterQueryParams(d, map[string]string{"tags": "tag"})
+	if err != nil {
+		return diag.FromErr(err)
 	}
-
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	// the tags filter takes a comma separated list of tags
+	if tags, ok := filters["tag"]; ok {
+		filters["tag"] = strings.Split(strings.Join(tags, ","), ",")
 	}
 
-	res, err := api.Dcim.DcimDevicesList(params, nil)
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.DeviceWithConfigContext, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Dcim.DcimDevicesList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var filteredDevices []*models.DeviceWithConfigContext
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		r := regexp.MustCompile(nameRegex.(string))
-		for _, device := range res.GetPayload().Results {
+		for _, device := range results {
 			if r.MatchString(*device.Name) {
 				filteredDevices = append(filteredDevices, device)
 			}
 		}
 	} else {
-		filteredDevices = res.GetPayload().Results
+		filteredDevices = results
 	}
 
 	var s []map[string]interface{}
@@ -334,5 +284,5 @@ func dataSourceNetboxDevicesRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("devices", s)
+	return diag.FromErr(d.Set("devices", s))
 }
@@ -95,6 +95,22 @@ func TestAccNetboxDevicesDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.netbox_devices.multiple_filter_devices", "devices.0.tags.#", "2"),
 				),
 			},
+			{
+				Config: dependencies + testAccNetboxDeviceDataSourceFilterLookupExpressions,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_devices.test", "devices.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_devices.test", "devices.0.name", "netbox_device.test2", "name"),
+					resource.TestCheckResourceAttrPair("data.netbox_devices.test", "devices.1.name", "netbox_device.test3", "name"),
+				),
+			},
+			{
+				Config: dependencies + testAccNetboxDeviceDataSourceFilterRepeated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_devices.test", "devices.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_devices.test", "devices.0.name", "netbox_device.test0", "name"),
+					resource.TestCheckResourceAttrPair("data.netbox_devices.test", "devices.1.name", "netbox_device.test3", "name"),
+				),
+			},
 		},
 	})
 }
@@ -308,6 +324,38 @@ data "netbox_devices" "test" {
   }
 }`
 
+const testAccNetboxDeviceDataSourceFilterLookupExpressions = `
+data "netbox_devices" "test" {
+  filter {
+    name  = "tenant_id"
+    value = netbox_tenant.test.id
+  }
+  filter {
+    name  = "name__iew"
+    value = "_REGEX"
+  }
+  filter {
+    name  = "asset_tag__isnull"
+    value = "true"
+  }
+}`
+
+const testAccNetboxDeviceDataSourceFilterRepeated = `
+data "netbox_devices" "test" {
+  filter {
+    name  = "tenant_id"
+    value = netbox_tenant.test.id
+  }
+  filter {
+    name  = "serial"
+    value = "ABCDEF0"
+  }
+  filter {
+    name  = "serial"
+    value = "ABCDEF3"
+  }
+}`
+
 func TestAccNetboxDevicesDataSource_CustomFields(t *testing.T) {
 	testSlug := "device_ds_customfields"
 	testName := testAccGetTestName(testSlug)
@@ -369,6 +417,48 @@ resource "netbox_device" "test" {
 					resource.TestCheckResourceAttr("data.netbox_devices.test", "devices.0.custom_fields."+testField, "81"),
 				),
 			},
+			{
+				Config: testAccNetboxDeviceFullDependencies(testName) + fmt.Sprintf(`
+data "netbox_devices" "test" {
+  depends_on = [
+    netbox_device.test,
+    netbox_custom_field.test,
+  ]
+
+  filter {
+    name  = "cf_%[1]s"
+    value = "81"
+  }
+}
+
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["dcim.device"]
+}
+
+resource "netbox_device" "test" {
+  name = "%[2]s"
+  comments = "thisisacomment"
+  description = "thisisadescription"
+  tenant_id = netbox_tenant.test.id
+  platform_id = netbox_platform.test.id
+  role_id = netbox_device_role.test.id
+  device_type_id = netbox_device_type.test.id
+  tags = ["%[2]sa"]
+  site_id = netbox_site.test.id
+  cluster_id = netbox_cluster.test.id
+  location_id = netbox_location.test.id
+  status = "staged"
+  serial = "ABCDEF"
+  custom_fields = {"${netbox_custom_field.test.name}" = "81"}
+}
+`, testField, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_devices.test", "devices.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_devices.test", "devices.0.name", testName),
+				),
+			},
 		},
 	})
 }
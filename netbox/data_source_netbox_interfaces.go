@@ -1,12 +1,12 @@
 package netbox
 
 import (
-	"errors"
-	"fmt"
+	"context"
 	"regexp"
 
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,25 +14,10 @@ import (
 
 func dataSourceNetboxInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxInterfaceRead,
+		ReadContext: dataSourceNetboxInterfaceRead,
 		Description: `:meta:subcategory:Virtualization:`,
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"filter": filterSchema("interfaces"),
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
@@ -141,55 +126,43 @@ func dataSourceNetboxInterfaces() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxInterfaceRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := virtualization.NewVirtualizationInterfacesListParams()
+	params := virtualization.NewVirtualizationInterfacesListParams().WithContext(ctx)
 
-	params.Limit = getOptionalInt(d, "limit")
-
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "cluster_id":
-				params.ClusterID = &vString
-			case "mac_address":
-				params.MacAddress = &vString
-			case "name":
-				params.Name = &vString
-			case "tag":
-				params.Tag = []string{vString} //TODO: switch schema to list?
-			case "vm_id":
-				params.VirtualMachineID = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
-		}
+	filters, err := filterQueryParams(d, map[string]string{"vm_id": "virtual_machine_id"})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	res, err := api.Virtualization.VirtualizationInterfacesList(params, nil)
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.VMInterface, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Virtualization.VirtualizationInterfacesList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+	if len(results) == 0 {
+		return diag.Errorf("no result")
 	}
 
 	var filteredInterfaces []*models.VMInterface
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		r := regexp.MustCompile(nameRegex.(string))
-		for _, vmInterface := range res.GetPayload().Results {
+		for _, vmInterface := range results {
 			if r.MatchString(*vmInterface.Name) {
 				filteredInterfaces = append(filteredInterfaces, vmInterface)
 			}
 		}
 	} else {
-		filteredInterfaces = res.GetPayload().Results
+		filteredInterfaces = results
 	}
 
 	var s []map[string]interface{}
@@ -236,7 +209,7 @@ func dataSourceNetboxInterfaceRead(d *schema.ResourceData, m interface{}) error
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("interfaces", s)
+	return diag.FromErr(d.Set("interfaces", s))
 }
 
 func flattenVlanAttributes(vlans []*models.NestedVLAN) []map[string]interface{} {
@@ -1,15 +1,18 @@
 package netbox
 
 import (
+	"context"
+	"strconv"
+
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"strconv"
 )
 
 func dataSourceNetboxIPAddress() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxIPAddressRead,
+		ReadContext: dataSourceNetboxIPAddressRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -102,17 +105,17 @@ func dataSourceNetboxIPAddress() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxIPAddressRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxIPAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id := d.Get("id").(int)
 
-	params := ipam.NewIpamIPAddressesReadParams()
+	params := ipam.NewIpamIPAddressesReadParams().WithContext(ctx)
 	params.SetID(int64(id))
 
 	res, err := api.Ipam.IpamIPAddressesRead(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	result := res.GetPayload()
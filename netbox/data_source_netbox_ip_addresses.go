@@ -1,11 +1,11 @@
 package netbox
 
 import (
-	"errors"
-	"fmt"
+	"context"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,30 +13,15 @@ import (
 
 func dataSourceNetboxIPAddresses() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxIPAddressesRead,
+		ReadContext: dataSourceNetboxIPAddressesRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"filter": filterSchema("IP addresses"),
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
-				Default:          1000,
+				Default:          0,
 			},
 			"ip_addresses": {
 				Type:     schema.TypeList,
@@ -135,62 +120,34 @@ func dataSourceNetboxIPAddresses() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxIPAddressesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxIPAddressesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := ipam.NewIpamIPAddressesListParams()
+	params := ipam.NewIpamIPAddressesListParams().WithContext(ctx)
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	filters, err := filterQueryParams(d, map[string]string{"ip_address": "address", "vm_interface_id": "vminterface_id", "parent_prefix": "parent"})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		var tags []string
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "dns_name":
-				params.DNSName = &vString
-			case "interface_id":
-				params.InterfaceID = &vString
-			case "device_id":
-				params.DeviceID = &vString
-			case "ip_address":
-				params.Address = &vString
-			case "vm_interface_id":
-				params.VminterfaceID = &vString
-			case "role":
-				params.Role = &vString
-			case "status":
-				params.Status = &vString
-			case "vrf":
-				params.Vrf = &vString
-			case "tenant":
-				params.Tenant = &vString
-			case "parent_prefix":
-				params.Parent = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.IPAddress, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Ipam.IpamIPAddressesList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
 		}
-	}
-
-	res, err := api.Ipam.IpamIPAddressesList(params, nil)
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+	if len(results) == 0 {
+		return diag.Errorf("no result")
 	}
 
-	filteredIPAddresses := res.GetPayload().Results
+	filteredIPAddresses := results
 
 	var s []map[string]interface{}
 	for _, v := range filteredIPAddresses {
@@ -225,7 +182,7 @@ func dataSourceNetboxIPAddressesRead(d *schema.ResourceData, m interface{}) erro
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("ip_addresses", s)
+	return diag.FromErr(d.Set("ip_addresses", s))
 }
 
 func flattenTenant(tenant *models.NestedTenant) []map[string]interface{} {
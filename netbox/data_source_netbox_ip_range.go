@@ -1,17 +1,18 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceNetboxIPRange() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxIPRangeRead,
+		ReadContext: dataSourceNetboxIPRangeRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -27,12 +28,12 @@ func dataSourceNetboxIPRange() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxIPRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	contains := d.Get("contains").(string)
 
-	params := ipam.NewIpamIPRangesListParams()
+	params := ipam.NewIpamIPRangesListParams().WithContext(ctx)
 	params.Contains = &contains
 
 	limit := int64(2) // Limit of 2 is enough
@@ -40,14 +41,14 @@ func dataSourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
 
 	res, err := api.Ipam.IpamIPRangesList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one ip range returned, specify a more narrow filter")
+		return diag.Errorf("more than one ip range returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no ip range found matching filter")
+		return diag.Errorf("no ip range found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.Set("id", result.ID)
@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxIPAMRole() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxIPAMRoleRead,
+		ReadContext: dataSourceNetboxIPAMRoleRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -33,12 +34,12 @@ func dataSourceNetboxIPAMRole() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxIPAMRoleRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxIPAMRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
-	params := ipam.NewIpamRolesListParams()
+	params := ipam.NewIpamRolesListParams().WithContext(ctx)
 	params.Name = &name
 
 	limit := int64(2) // Limit of 2 is enough
@@ -46,14 +47,14 @@ func dataSourceNetboxIPAMRoleRead(d *schema.ResourceData, m interface{}) error {
 
 	res, err := api.Ipam.IpamRolesList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one ipam role returned, specify a more narrow filter")
+		return diag.Errorf("more than one ipam role returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no ipam role found matching filter")
+		return diag.Errorf("no ipam role found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
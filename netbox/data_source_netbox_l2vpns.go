@@ -0,0 +1,137 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxL2VPNs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxL2VPNsRead,
+		Description: `:meta:subcategory:VPN Tunnels:`,
+		Schema: map[string]*schema.Schema{
+			"filter": filterSchema("L2VPNs"),
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			"l2vpns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"import_target_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"export_target_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"tenant_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaRead,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxL2VPNsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	filters, err := filterQueryParams(d, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*netboxL2VPN, int64, error) {
+		query := url.Values{}
+		for name, values := range filters {
+			query[name] = values
+		}
+		query.Set("limit", strconv.FormatInt(limit, 10))
+		query.Set("offset", strconv.FormatInt(offset, 10))
+
+		var res struct {
+			Count   int64          `json:"count"`
+			Results []*netboxL2VPN `json:"results"`
+		}
+		if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, l2vpnsPath, query, nil, &res); err != nil {
+			return nil, 0, err
+		}
+		return res.Results, res.Count, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(results) == 0 {
+		return diag.Errorf("no result")
+	}
+
+	var s []map[string]interface{}
+	for _, v := range results {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		mapping["name"] = v.Name
+		mapping["slug"] = v.Slug
+		if v.Type != nil {
+			mapping["type"] = v.Type.Value
+		}
+		if v.Identifier != nil {
+			mapping["identifier"] = *v.Identifier
+		}
+		mapping["import_target_ids"] = getIDsFromNestedRouteTarget(v.ImportTargets)
+		mapping["export_target_ids"] = getIDsFromNestedRouteTarget(v.ExportTargets)
+		if v.Tenant != nil {
+			mapping["tenant_id"] = v.Tenant.ID
+		}
+		mapping["description"] = v.Description
+		mapping["tags"] = getTagListFromNestedTagList(v.Tags)
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return diag.FromErr(d.Set("l2vpns", s))
+}
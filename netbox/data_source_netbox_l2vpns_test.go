@@ -0,0 +1,70 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxL2VPNsDataSource_basic(t *testing.T) {
+	testSlug := "l2vpns_ds"
+	testName := testAccGetTestName(testSlug)
+	setUp := fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_l2vpn" "test_1" {
+  name       = "%[1]s_1"
+  type       = "vxlan"
+  identifier = 10001
+}
+
+resource "netbox_l2vpn" "test_2" {
+  name = "%[1]s_2"
+  type = "vpls"
+  tags = [netbox_tag.test.name]
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp,
+			},
+			{
+				Config: setUp + fmt.Sprintf(`
+data "netbox_l2vpns" "by_name" {
+  filter {
+    name  = "name"
+    value = "%[1]s_1"
+  }
+}
+
+data "netbox_l2vpns" "by_tag" {
+  filter {
+    name  = "tag"
+    value = netbox_tag.test.slug
+  }
+}
+
+data "netbox_l2vpns" "by_prefix" {
+  filter {
+    name  = "name__isw"
+    value = "%[1]s"
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.by_name", "l2vpns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_l2vpns.by_name", "l2vpns.0.id", "netbox_l2vpn.test_1", "id"),
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.by_name", "l2vpns.0.type", "vxlan"),
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.by_name", "l2vpns.0.identifier", "10001"),
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.by_tag", "l2vpns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_l2vpns.by_tag", "l2vpns.0.id", "netbox_l2vpn.test_2", "id"),
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.by_prefix", "l2vpns.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -1,17 +1,18 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxLocation() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxLocationRead,
+		ReadContext: dataSourceNetboxLocationRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -54,9 +55,9 @@ func dataSourceNetboxLocation() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxLocationRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxLocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-	params := dcim.NewDcimLocationsListParams()
+	params := dcim.NewDcimLocationsListParams().WithContext(ctx)
 
 	params.Limit = int64ToPtr(2)
 	if name, ok := d.Get("name").(string); ok && name != "" {
@@ -79,13 +80,13 @@ func dataSourceNetboxLocationRead(d *schema.ResourceData, m interface{}) error {
 	res, err := api.Dcim.DcimLocationsList(params, nil)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one location returned, specify a more narrow filter")
+		return diag.Errorf("more than one location returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no location found matching filter")
+		return diag.Errorf("no location found matching filter")
 	}
 
 	location := res.GetPayload().Results[0]
@@ -1,10 +1,12 @@
 package netbox
 
 import (
-	"fmt"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,28 +14,10 @@ import (
 
 func dataSourceNetboxLocations() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxLocationsRead,
+		ReadContext: dataSourceNetboxLocationsRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "A list of filter to apply to the API query when requesting locations.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The name of the field to filter on. Supported fields are: .",
-						},
-						"value": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The value to pass to the specified filter.",
-						},
-					},
-				},
-			},
+			"filter": filterSchema("locations"),
 			"tags": {
 				Type: schema.TypeSet,
 				Elem: &schema.Schema{
@@ -97,41 +81,13 @@ func dataSourceNetboxLocations() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxLocationsRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxLocationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-	params := dcim.NewDcimLocationsListParams()
-
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
-	}
+	params := dcim.NewDcimLocationsListParams().WithContext(ctx)
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "name":
-				params.Name = &vString
-			case "slug":
-				params.Slug = &vString
-			case "site":
-				params.Site = &vString
-			case "site_id":
-				params.SiteID = &vString
-			case "parent_id":
-				params.ParentID = &vString
-			case "tenant":
-				params.Tenant = &vString
-			case "tenant_id":
-				params.TenantID = &vString
-			case "status":
-				params.Status = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
-		}
+	filters, err := filterQueryParams(d, nil)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	if tags, ok := d.GetOk("tags"); ok {
 		tagSet := tags.(*schema.Set)
@@ -140,13 +96,20 @@ func dataSourceNetboxLocationsRead(d *schema.ResourceData, m interface{}) error
 			params.Tag = append(params.Tag, tagV)
 		}
 	}
-	res, err := api.Dcim.DcimLocationsList(params, nil)
-
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.Location, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Dcim.DcimLocationsList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	filteredLocations := res.GetPayload().Results
+	filteredLocations := results
 
 	var s []map[string]any
 	for _, v := range filteredLocations {
@@ -175,5 +138,5 @@ func dataSourceNetboxLocationsRead(d *schema.ResourceData, m interface{}) error
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("locations", s)
+	return diag.FromErr(d.Set("locations", s))
 }
@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxManufacturer() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxManufacturerRead,
+		ReadContext: dataSourceNetboxManufacturerRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -27,10 +28,10 @@ func dataSourceNetboxManufacturer() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxManufacturerRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxManufacturerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := dcim.NewDcimManufacturersListParams()
+	params := dcim.NewDcimManufacturersListParams().WithContext(ctx)
 	params.Limit = int64ToPtr(2)
 
 	if name, ok := d.Get("name").(string); ok && name != "" {
@@ -39,14 +40,14 @@ func dataSourceNetboxManufacturerRead(d *schema.ResourceData, m interface{}) err
 
 	res, err := api.Dcim.DcimManufacturersList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one manufacturer returned, specify a more narrow filter")
+		return diag.Errorf("more than one manufacturer returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no manufacturer found matching filter")
+		return diag.Errorf("no manufacturer found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
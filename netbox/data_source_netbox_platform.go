@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxPlatform() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxPlatformRead,
+		ReadContext: dataSourceNetboxPlatformRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -29,25 +30,25 @@ func dataSourceNetboxPlatform() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxPlatformRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxPlatformRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
-	params := dcim.NewDcimPlatformsListParams()
+	params := dcim.NewDcimPlatformsListParams().WithContext(ctx)
 	params.Name = &name
 	limit := int64(2) // Limit of 2 is enough
 	params.Limit = &limit
 
 	res, err := api.Dcim.DcimPlatformsList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one platform returned, specify a more narrow filter")
+		return diag.Errorf("more than one platform returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no platform found matching filter")
+		return diag.Errorf("no platform found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -1,10 +1,11 @@
 package netbox
 
 import (
-	"fmt"
-	"strconv"
+	"context"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,28 +13,10 @@ import (
 
 func dataSourceNetboxPrefixes() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxPrefixesRead,
+		ReadContext: dataSourceNetboxPrefixesRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "A list of filters to apply to the API query when requesting prefixes.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The name of the field to filter on. Supported fields are: `prefix`, `contains`, `vlan_vid`, `vrf_id`, `vlan_id`, `status`, `tenant_id`, `site_id`, & `tag`.",
-						},
-						"value": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The value to pass to the specified filter.",
-						},
-					},
-				},
-			},
+			"filter": filterSchema("prefixes"),
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
@@ -102,56 +85,30 @@ func dataSourceNetboxPrefixes() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxPrefixesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxPrefixesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := ipam.NewIpamPrefixesListParams()
+	params := ipam.NewIpamPrefixesListParams().WithContext(ctx)
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	filters, err := filterQueryParams(d, nil)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "prefix":
-				params.Prefix = &vString
-			case "vlan_vid":
-				float, err := strconv.ParseFloat(vString, 64)
-				if err != nil {
-					return err
-				}
-				params.VlanVid = &float
-			case "contains":
-				params.Contains = &vString
-			case "vrf_id":
-				params.VrfID = &vString
-			case "vlan_id":
-				params.VlanID = &vString
-			case "status":
-				params.Status = &vString
-			case "tenant_id":
-				params.TenantID = &vString
-			case "site_id":
-				params.SiteID = &vString
-			case "tag":
-				params.Tag = []string{vString}
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.Prefix, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Ipam.IpamPrefixesList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
 		}
-	}
-
-	res, err := api.Ipam.IpamPrefixesList(params, nil)
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	filteredPrefixes := res.GetPayload().Results
+	filteredPrefixes := results
 
 	var s []map[string]interface{}
 	for _, v := range filteredPrefixes {
@@ -190,5 +147,5 @@ func dataSourceNetboxPrefixesRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("prefixes", s)
+	return diag.FromErr(d.Set("prefixes", s))
 }
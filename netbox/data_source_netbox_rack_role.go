@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxRackRole() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxRackRoleRead,
+		ReadContext: dataSourceNetboxRackRoleRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -34,25 +35,25 @@ func dataSourceNetboxRackRole() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxRackRoleRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxRackRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
-	params := dcim.NewDcimRackRolesListParams()
+	params := dcim.NewDcimRackRolesListParams().WithContext(ctx)
 	params.Name = &name
 	limit := int64(2) // Limit of 2 is enough
 	params.Limit = &limit
 
 	res, err := api.Dcim.DcimRackRolesList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one rack role returned, specify a more narrow filter")
+		return diag.Errorf("more than one rack role returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no rack role found matching filter")
+		return diag.Errorf("no rack role found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -1,10 +1,11 @@
 package netbox
 
 import (
-	"errors"
-	"fmt"
+	"context"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,25 +13,10 @@ import (
 
 func dataSourceNetboxRacks() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxRacksRead,
+		ReadContext: dataSourceNetboxRacksRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"filter": filterSchema("racks"),
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
@@ -146,88 +132,34 @@ func dataSourceNetboxRacks() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxRacksRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxRacksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := dcim.NewDcimRacksListParams()
+	params := dcim.NewDcimRacksListParams().WithContext(ctx)
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	filters, err := filterQueryParams(d, map[string]string{"type_id": "type"})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "asset_tag":
-				params.AssetTag = &vString
-			case "contact":
-				params.Contact = &vString
-			case "contact_group":
-				params.ContactGroup = &vString
-			case "contact_role":
-				params.ContactRole = &vString
-			case "desc_units":
-				params.DescUnits = &vString
-			case "facility_id":
-				params.FacilityID = &vString
-			case "id":
-				params.ID = &vString
-			case "location_id":
-				params.LocationID = &vString
-			case "max_weight":
-				params.MaxWeight = &vString
-			case "mounting_depth":
-				params.MountingDepth = &vString
-			case "name":
-				params.Name = &vString
-			case "outer_depth":
-				params.OuterDepth = &vString
-			case "outer_unit":
-				params.OuterUnit = &vString
-			case "outer_width":
-				params.OuterWidth = &vString
-			case "region_id":
-				params.RegionID = &vString
-			case "role_id":
-				params.RoleID = &vString
-			case "serial":
-				params.Serial = &vString
-			case "site_id":
-				params.SiteID = &vString
-			case "status":
-				params.Status = &vString
-			case "tenant_id":
-				params.TenantID = &vString
-			case "type_id":
-				params.Type = &vString
-			case "u_height":
-				params.UHeight = &vString
-			case "weight":
-				params.Weight = &vString
-			case "weight_unit":
-				params.WeightUnit = &vString
-			case "width":
-				params.Width = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.Rack, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Dcim.DcimRacksList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
 		}
-	}
-
-	res, err := api.Dcim.DcimRacksList(params, nil)
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+	if len(results) == 0 {
+		return diag.Errorf("no result")
 	}
 
-	filteredRacks := res.GetPayload().Results
+	filteredRacks := results
 
 	var s []map[string]interface{}
 	for _, v := range filteredRacks {
@@ -276,5 +208,5 @@ func dataSourceNetboxRacksRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("racks", s)
+	return diag.FromErr(d.Set("racks", s))
 }
@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxRegion() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxRegionRead,
+		ReadContext: dataSourceNetboxRegionRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
 			"filter": {
@@ -57,10 +58,10 @@ func dataSourceNetboxRegion() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxRegionRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxRegionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := dcim.NewDcimRegionsListParams()
+	params := dcim.NewDcimRegionsListParams().WithContext(ctx)
 
 	if filter, ok := d.GetOk("filter"); ok {
 		var filterParams = filter.(*schema.Set)
@@ -88,14 +89,14 @@ func dataSourceNetboxRegionRead(d *schema.ResourceData, m interface{}) error {
 
 	res, err := api.Dcim.DcimRegionsList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one region returned, specify a more narrow filter")
+		return diag.Errorf("more than one region returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no region found matching filter")
+		return diag.Errorf("no region found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
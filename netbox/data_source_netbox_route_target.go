@@ -1,17 +1,18 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceNetboxRouteTarget() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxRouteTargetRead,
+		ReadContext: dataSourceNetboxRouteTargetRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -32,12 +33,12 @@ func dataSourceNetboxRouteTarget() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxRouteTargetRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxRouteTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
 
-	params := ipam.NewIpamRouteTargetsListParams()
+	params := ipam.NewIpamRouteTargetsListParams().WithContext(ctx)
 	params.Name = &name
 
 	limit := int64(2)
@@ -46,14 +47,14 @@ func dataSourceNetboxRouteTargetRead(d *schema.ResourceData, m interface{}) erro
 	res, err := api.Ipam.IpamRouteTargetsList(params, nil)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one route target returned, specify a more narrow filter")
+		return diag.Errorf("more than one route target returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no route target found matching filter")
+		return diag.Errorf("no route target found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxSite() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxSiteRead,
+		ReadContext: dataSourceNetboxSiteRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -76,9 +77,9 @@ func dataSourceNetboxSite() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxSiteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-	params := dcim.NewDcimSitesListParams()
+	params := dcim.NewDcimSitesListParams().WithContext(ctx)
 
 	params.Limit = int64ToPtr(2)
 	if name, ok := d.Get("name").(string); ok && name != "" {
@@ -96,14 +97,14 @@ func dataSourceNetboxSiteRead(d *schema.ResourceData, m interface{}) error {
 
 	res, err := api.Dcim.DcimSitesList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one site returned, specify a more narrow filter")
+		return diag.Errorf("more than one site returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no site found matching filter")
+		return diag.Errorf("no site found matching filter")
 	}
 
 	site := res.GetPayload().Results[0]
@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxSiteGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxSiteGroupRead,
+		ReadContext: dataSourceNetboxSiteGroupRead,
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -33,9 +34,9 @@ func dataSourceNetboxSiteGroup() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxSiteGroupRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxSiteGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-	params := dcim.NewDcimSiteGroupsListParams()
+	params := dcim.NewDcimSiteGroupsListParams().WithContext(ctx)
 
 	if name, ok := d.Get("name").(string); ok && name != "" {
 		params.Name = &name
@@ -50,14 +51,14 @@ func dataSourceNetboxSiteGroupRead(d *schema.ResourceData, m interface{}) error
 
 	res, err := api.Dcim.DcimSiteGroupsList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one site group returned, specify a more narrow filter")
+		return diag.Errorf("more than one site group returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no site group found matching filter")
+		return diag.Errorf("no site group found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
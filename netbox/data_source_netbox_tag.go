@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxTag() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxTagRead,
+		ReadContext: dataSourceNetboxTagRead,
 		Description: `:meta:subcategory:Extras:`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -29,25 +30,25 @@ func dataSourceNetboxTag() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxTagRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
-	params := extras.NewExtrasTagsListParams()
+	params := extras.NewExtrasTagsListParams().WithContext(ctx)
 	params.Name = &name
 	limit := int64(2) // Limit of 2 is enough
 	params.Limit = &limit
 
 	res, err := api.Extras.ExtrasTagsList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one tag returned, specify a more narrow filter")
+		return diag.Errorf("more than one tag returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no tag found matching filter")
+		return diag.Errorf("no tag found matching filter")
 	}
 
 	result := res.GetPayload().Results[0]
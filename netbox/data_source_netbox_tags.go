@@ -1,10 +1,11 @@
 package netbox
 
 import (
-	"errors"
-	"fmt"
+	"context"
 
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,25 +13,10 @@ import (
 
 func dataSourceNetboxTags() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxTagsRead,
+		ReadContext: dataSourceNetboxTagsRead,
 		Description: `:meta:subcategory:Extras:`,
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"filter": filterSchema("tags"),
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
@@ -69,73 +55,34 @@ func dataSourceNetboxTags() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxTagsRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxTagsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := extras.NewExtrasTagsListParams()
+	params := extras.NewExtrasTagsListParams().WithContext(ctx)
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	filters, err := filterQueryParams(d, nil)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		filterParams := filter.(*schema.Set)
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "id":
-				params.ID = &vString
-			case "id__gt":
-				params.IDGt = &vString
-			case "id__gte":
-				params.IDGte = &vString
-			case "id__lt":
-				params.IDLt = &vString
-			case "id__lte":
-				params.IDLte = &vString
-			case "name":
-				params.Name = &vString
-			case "name__ic":
-				params.NameIc = &vString
-			case "name__niew":
-				params.NameNiew = &vString
-			case "name__iew":
-				params.NameIew = &vString
-			case "name__nisw":
-				params.NameNisw = &vString
-			case "name__isw":
-				params.NameIsw = &vString
-			case "slug":
-				params.Slug = &vString
-			case "slug__ic":
-				params.SlugIc = &vString
-			case "slug__niew":
-				params.SlugNiew = &vString
-			case "slug__iew":
-				params.SlugIew = &vString
-			case "slug__nisw":
-				params.SlugNisw = &vString
-			case "slug__isw":
-				params.SlugIsw = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.Tag, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Extras.ExtrasTagsList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
 		}
-	}
-
-	res, err := api.Extras.ExtrasTagsList(params, nil)
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+	if len(results) == 0 {
+		return diag.Errorf("no result")
 	}
 
 	var s []map[string]interface{}
-	results := res.GetPayload().Results
 	for _, v := range results {
 		mapping := make(map[string]interface{})
 
@@ -149,5 +96,5 @@ func dataSourceNetboxTagsRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("tags", s)
+	return diag.FromErr(d.Set("tags", s))
 }
@@ -0,0 +1,143 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxVirtualDeviceContexts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxVirtualDeviceContextsRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
+		Schema: map[string]*schema.Schema{
+			"filter": filterSchema("virtual device contexts"),
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			"virtual_device_contexts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"identifier": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary_ipv4_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"primary_ipv6_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaRead,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxVirtualDeviceContextsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	filters, err := filterQueryParams(d, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*netboxVirtualDeviceContext, int64, error) {
+		query := url.Values{}
+		for name, values := range filters {
+			query[name] = values
+		}
+		query.Set("limit", strconv.FormatInt(limit, 10))
+		query.Set("offset", strconv.FormatInt(offset, 10))
+
+		var res struct {
+			Count   int64                         `json:"count"`
+			Results []*netboxVirtualDeviceContext `json:"results"`
+		}
+		if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, virtualDeviceContextsPath, query, nil, &res); err != nil {
+			return nil, 0, err
+		}
+		return res.Results, res.Count, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(results) == 0 {
+		return diag.Errorf("no result")
+	}
+
+	var s []map[string]interface{}
+	for _, v := range results {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		if v.Name != nil {
+			mapping["name"] = *v.Name
+		}
+		if v.Device != nil {
+			mapping["device_id"] = v.Device.ID
+		}
+		if v.Identifier != nil {
+			mapping["identifier"] = *v.Identifier
+		}
+		if v.Status != nil {
+			mapping["status"] = v.Status.Value
+		}
+		if v.PrimaryIp4 != nil {
+			mapping["primary_ipv4_id"] = v.PrimaryIp4.ID
+		}
+		if v.PrimaryIp6 != nil {
+			mapping["primary_ipv6_id"] = v.PrimaryIp6.ID
+		}
+		if v.Tenant != nil {
+			mapping["tenant_id"] = v.Tenant.ID
+		}
+		mapping["description"] = v.Description
+		mapping["tags"] = getTagListFromNestedTagList(v.Tags)
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return diag.FromErr(d.Set("virtual_device_contexts", s))
+}
@@ -0,0 +1,67 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxVirtualDeviceContextsDataSource_basic(t *testing.T) {
+	testSlug := "vdcs_ds_basic"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxVirtualDeviceContextFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_device_context" "test1" {
+  name       = "%[1]s_1"
+  device_id  = netbox_device.test.id
+  identifier = 1
+}
+
+resource "netbox_virtual_device_context" "test2" {
+  name       = "%[1]s_2"
+  device_id  = netbox_device.test.id
+  identifier = 2
+  status     = "offline"
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp,
+			},
+			{
+				Config: setUp + `
+data "netbox_virtual_device_contexts" "test" {
+  filter {
+    name  = "device_id"
+    value = netbox_device.test.id
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_virtual_device_contexts.test", "virtual_device_contexts.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_device_contexts.test", "virtual_device_contexts.0.device_id", "netbox_device.test", "id"),
+				),
+			},
+			{
+				Config: setUp + `
+data "netbox_virtual_device_contexts" "test" {
+  filter {
+    name  = "device_id"
+    value = netbox_device.test.id
+  }
+  filter {
+    name  = "status"
+    value = "offline"
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_virtual_device_contexts.test", "virtual_device_contexts.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_virtual_device_contexts.test", "virtual_device_contexts.0.id", "netbox_virtual_device_context.test2", "id"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_device_contexts.test", "virtual_device_contexts.0.identifier", "2"),
+					resource.TestCheckResourceAttr("data.netbox_virtual_device_contexts.test", "virtual_device_contexts.0.status", "offline"),
+				),
+			},
+		},
+	})
+}
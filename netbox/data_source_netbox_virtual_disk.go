@@ -1,11 +1,12 @@
 package netbox
 
 import (
-	"fmt"
+	"context"
 	"regexp"
 
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceNetboxVirtualDisk() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxVirtualDiskRead,
+		ReadContext: dataSourceNetboxVirtualDiskRead,
 		Description: `:meta:subcategory:Virtualization:`,
 		Schema: map[string]*schema.Schema{
 			"filter": {
@@ -82,50 +83,38 @@ func dataSourceNetboxVirtualDisk() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxVirtualDiskRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxVirtualDiskRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-	params := virtualization.NewVirtualizationVirtualDisksListParams()
+	params := virtualization.NewVirtualizationVirtualDisksListParams().WithContext(ctx)
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.([]interface{})
-		var tags []string
-		for _, f := range filterParams {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "name":
-				params.NameIc = &vString
-			case "tag":
-				tags = append(tags, vString)
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
-		}
-		if len(tags) > 0 {
-			params.Tag = tags
-		}
-	}
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	filters, err := filterQueryParams(d, map[string]string{"name": "name__ic"})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	res, err := api.Virtualization.VirtualizationVirtualDisksList(params, nil)
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.VirtualDisk, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Virtualization.VirtualizationVirtualDisksList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var filteredDisks []*models.VirtualDisk
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		r := regexp.MustCompile(nameRegex.(string))
-		for _, disk := range res.GetPayload().Results {
+		for _, disk := range results {
 			if disk.Name != nil && r.MatchString(*disk.Name) {
 				filteredDisks = append(filteredDisks, disk)
 			}
 		}
 	} else {
-		filteredDisks = res.GetPayload().Results
+		filteredDisks = results
 	}
 
 	var s []map[string]interface{}
@@ -162,5 +151,5 @@ func dataSourceNetboxVirtualDiskRead(d *schema.ResourceData, m interface{}) erro
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("virtual_disks", s)
+	return diag.FromErr(d.Set("virtual_disks", s))
 }
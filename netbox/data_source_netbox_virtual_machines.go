@@ -1,13 +1,13 @@
 package netbox
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
-	"fmt"
 	"regexp"
 
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,25 +15,10 @@ import (
 
 func dataSourceNetboxVirtualMachine() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxVirtualMachineRead,
+		ReadContext: dataSourceNetboxVirtualMachineRead,
 		Description: `:meta:subcategory:Virtualization:`,
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"filter": filterSchema("virtual machines"),
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -150,72 +135,43 @@ func dataSourceNetboxVirtualMachine() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := virtualization.NewVirtualizationVirtualMachinesListParams()
-
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		var tags []string
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "cluster_id":
-				params.ClusterID = &vString
-			case "cluster_group":
-				params.ClusterGroup = &vString
-			case "device_id":
-				params.Name = &vString
-			case "device":
-				params.Name = &vString
-			case "name":
-				params.Name = &vString
-			case "region":
-				params.Region = &vString
-			case "role":
-				params.Role = &vString
-			case "site":
-				params.Site = &vString
-			case "tenant_id":
-				params.TenantID = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
-			case "status":
-				params.Status = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
-		}
-	}
+	params := virtualization.NewVirtualizationVirtualMachinesListParams().WithContext(ctx)
 
-	if limit, ok := d.GetOk("limit"); ok {
-		limitInt := int64(limit.(int))
-		params.Limit = &limitInt
+	filters, err := filterQueryParams(d, map[string]string{"device": "name", "device_id": "name"})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	res, err := api.Virtualization.VirtualizationVirtualMachinesList(params, nil)
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.VirtualMachineWithConfigContext, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Virtualization.VirtualizationVirtualMachinesList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+	if len(results) == 0 {
+		return diag.Errorf("no result")
 	}
 
 	var filteredVms []*models.VirtualMachineWithConfigContext
 	if nameRegex, ok := d.GetOk("name_regex"); ok {
 		r := regexp.MustCompile(nameRegex.(string))
-		for _, vm := range res.GetPayload().Results {
+		for _, vm := range results {
 			if r.MatchString(*vm.Name) {
 				filteredVms = append(filteredVms, vm)
 			}
 		}
 	} else {
-		filteredVms = res.GetPayload().Results
+		filteredVms = results
 	}
 
 	var s []map[string]interface{}
@@ -298,5 +254,5 @@ func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, m interface{}) e
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("vms", s)
+	return diag.FromErr(d.Set("vms", s))
 }
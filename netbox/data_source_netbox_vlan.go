@@ -1,17 +1,18 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceNetboxVlan() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxVlanRead,
+		ReadContext: dataSourceNetboxVlanRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
 			"vid": {
@@ -54,9 +55,9 @@ func dataSourceNetboxVlan() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxVlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-	params := ipam.NewIpamVlansListParams()
+	params := ipam.NewIpamVlansListParams().WithContext(ctx)
 
 	params.Limit = int64ToPtr(2)
 	if name, ok := d.Get("name").(string); ok && name != "" {
@@ -77,13 +78,13 @@ func dataSourceNetboxVlanRead(d *schema.ResourceData, m interface{}) error {
 
 	res, err := api.Ipam.IpamVlansList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one vlan returned, specify a more narrow filter")
+		return diag.Errorf("more than one vlan returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no vlan found matching filter")
+		return diag.Errorf("no vlan found matching filter")
 	}
 
 	vlan := res.GetPayload().Results[0]
@@ -1,17 +1,18 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceNetboxVlanGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxVlanGroupRead,
+		ReadContext: dataSourceNetboxVlanGroupRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -50,9 +51,9 @@ func dataSourceNetboxVlanGroup() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxVlanGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-	params := ipam.NewIpamVlanGroupsListParams()
+	params := ipam.NewIpamVlanGroupsListParams().WithContext(ctx)
 
 	params.Limit = int64ToPtr(2)
 	if name, ok := d.Get("name").(string); ok && name != "" {
@@ -70,14 +71,14 @@ func dataSourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error
 
 	res, err := api.Ipam.IpamVlanGroupsList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one vlan group returned, specify a more narrow filter")
+		return diag.Errorf("more than one vlan group returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no vlan group found matching filter")
+		return diag.Errorf("no vlan group found matching filter")
 	}
 
 	result := res.GetPayload().Results[0]
@@ -1,10 +1,11 @@
 package netbox
 
 import (
-	"errors"
-	"fmt"
+	"context"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,25 +13,10 @@ import (
 
 func dataSourceNetboxVlans() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxVlansRead,
+		ReadContext: dataSourceNetboxVlansRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
-			"filter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
-			},
+			"filter": filterSchema("VLANs"),
 			"limit": {
 				Type:             schema.TypeInt,
 				Optional:         true,
@@ -88,84 +74,34 @@ func dataSourceNetboxVlans() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxVlansRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxVlansRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	params := ipam.NewIpamVlansListParams()
+	params := ipam.NewIpamVlansListParams().WithContext(ctx)
 
-	if limitValue, ok := d.GetOk("limit"); ok {
-		params.Limit = int64ToPtr(int64(limitValue.(int)))
+	filters, err := filterQueryParams(d, nil)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if filter, ok := d.GetOk("filter"); ok {
-		var filterParams = filter.(*schema.Set)
-		var tags []string
-		for _, f := range filterParams.List() {
-			k := f.(map[string]interface{})["name"]
-			v := f.(map[string]interface{})["value"]
-			vString := v.(string)
-			switch k {
-			case "vid":
-				params.Vid = &vString
-			case "vid__gt":
-				params.VidGt = &vString
-			case "vid__gte":
-				params.VidGte = &vString
-			case "vid__lt":
-				params.VidLt = &vString
-			case "vid__lte":
-				params.VidLte = &vString
-			case "vid__n":
-				params.Vidn = &vString
-			case "group":
-				params.Group = &vString
-			case "group__n":
-				params.Groupn = &vString
-			case "group_id":
-				params.GroupID = &vString
-			case "group_id__n":
-				params.GroupIDn = &vString
-			case "tag":
-				tags = append(tags, vString)
-				params.Tag = tags
-			case "tenant":
-				params.Tenant = &vString
-			case "tenant__n":
-				params.Tenantn = &vString
-			case "tenant_group":
-				params.TenantGroup = &vString
-			case "tenant_group__n":
-				params.TenantGroupn = &vString
-			case "tenant_group_id":
-				params.TenantGroupID = &vString
-			case "tenant_group_id__n":
-				params.TenantGroupIDn = &vString
-			case "tenant_id":
-				params.TenantID = &vString
-			case "tenant_id__n":
-				params.TenantIDn = &vString
-			case "site_id":
-				params.SiteID = &vString
-			case "site_id__n":
-				params.SiteIDn = &vString
-			case "status":
-				params.Status = &vString
-			default:
-				return fmt.Errorf("'%s' is not a supported filter parameter", k)
-			}
+	results, err := fetchAllPages(int64(d.Get("limit").(int)), api.pageConcurrency, func(limit, offset int64) ([]*models.VLAN, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Ipam.IpamVlansList(&pageParams, api.withFilters(filters))
+		if err != nil {
+			return nil, 0, err
 		}
-	}
-
-	res, err := api.Ipam.IpamVlansList(params, nil)
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no result")
+	if len(results) == 0 {
+		return diag.Errorf("no result")
 	}
 
-	filteredVlans := res.GetPayload().Results
+	filteredVlans := results
 
 	var s []map[string]interface{}
 	for _, v := range filteredVlans {
@@ -199,5 +135,5 @@ func dataSourceNetboxVlansRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId(id.UniqueId())
-	return d.Set("vlans", s)
+	return diag.FromErr(d.Set("vlans", s))
 }
@@ -0,0 +1,87 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxVpnIpsecProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxVpnIpsecProfileRead,
+		Description: `:meta:subcategory:VPN Tunnels:`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ike_policy_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ipsec_policy_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxVpnIpsecProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	query := url.Values{"limit": {"2"}} // Limit of 2 is enough
+	if id, ok := d.GetOk("id"); ok {
+		query.Set("id", strconv.Itoa(id.(int)))
+	}
+	if name, ok := d.GetOk("name"); ok {
+		query.Set("name", name.(string))
+	}
+
+	var res struct {
+		Count   int64                    `json:"count"`
+		Results []*netboxVpnIpsecProfile `json:"results"`
+	}
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, vpnIpsecProfilesPath, query, nil, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if res.Count > int64(1) {
+		return diag.Errorf("more than one ipsec profile returned, specify a more narrow filter")
+	}
+	if res.Count == int64(0) {
+		return diag.Errorf("no ipsec profile found matching filter")
+	}
+
+	result := res.Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("name", result.Name)
+	d.Set("description", result.Description)
+	d.Set("mode", result.Mode.Value)
+	d.Set("ike_policy_id", result.IkePolicy.ID)
+	d.Set("ipsec_policy_id", result.IpsecPolicy.ID)
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
@@ -0,0 +1,49 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxVpnIpsecProfileDataSource_basic(t *testing.T) {
+	testSlug := "ipsec_profile_ds"
+	testName := testAccGetTestName(testSlug)
+	setUp := testAccNetboxVpnIpsecProfileFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_vpn_ipsec_profile" "test" {
+  name            = "%[1]s"
+  mode            = "esp"
+  ike_policy_id   = netbox_vpn_ike_policy.test.id
+  ipsec_policy_id = netbox_vpn_ipsec_policy.test.id
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp,
+			},
+			{
+				Config: setUp + fmt.Sprintf(`
+data "netbox_vpn_ipsec_profile" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_vpn_ipsec_profile.test", "id", "netbox_vpn_ipsec_profile.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_vpn_ipsec_profile.test", "mode", "esp"),
+					resource.TestCheckResourceAttrPair("data.netbox_vpn_ipsec_profile.test", "ike_policy_id", "netbox_vpn_ike_policy.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_vpn_ipsec_profile.test", "ipsec_policy_id", "netbox_vpn_ipsec_policy.test", "id"),
+				),
+			},
+			{
+				Config: setUp + `
+data "netbox_vpn_ipsec_profile" "test" {
+  name = "does-not-exist"
+}`,
+				ExpectError: regexp.MustCompile("no ipsec profile found matching filter"),
+			},
+		},
+	})
+}
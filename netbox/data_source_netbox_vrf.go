@@ -1,16 +1,17 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNetboxVrf() *schema.Resource {
 	return &schema.Resource{
-		Read:        dataSourceNetboxVrfRead,
+		ReadContext: dataSourceNetboxVrfRead,
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -25,11 +26,11 @@ func dataSourceNetboxVrf() *schema.Resource {
 	}
 }
 
-func dataSourceNetboxVrfRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNetboxVrfRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
-	params := ipam.NewIpamVrfsListParams()
+	params := ipam.NewIpamVrfsListParams().WithContext(ctx)
 	params.Name = &name
 	limit := int64(2) // Limit of 2 is enough
 	params.Limit = &limit
@@ -41,14 +42,14 @@ func dataSourceNetboxVrfRead(d *schema.ResourceData, m interface{}) error {
 
 	res, err := api.Ipam.IpamVrfsList(params, nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one vrf returned, specify a more narrow filter")
+		return diag.Errorf("more than one vrf returned, specify a more narrow filter")
 	}
 	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no vrf found matching filter")
+		return diag.Errorf("no vrf found matching filter")
 	}
 	result := res.GetPayload().Results[0]
 	d.SetId(strconv.FormatInt(result.ID, 10))
@@ -0,0 +1,157 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxWirelessLAN() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxWirelessLANRead,
+		Description: `:meta:subcategory:Wireless:`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "ssid"},
+			},
+			"ssid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "ssid"},
+			},
+			"group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"site_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Filters by the site of the scope, which also matches wireless LANs scoped to a location of the site.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vlan_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"scope_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scope_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"auth_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_cipher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_psk": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxWirelessLANRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	query := url.Values{"limit": {"2"}} // Limit of 2 is enough
+	if id, ok := d.GetOk("id"); ok {
+		query.Set("id", strconv.Itoa(id.(int)))
+	}
+	if ssid, ok := d.GetOk("ssid"); ok {
+		query.Set("ssid", ssid.(string))
+	}
+	if groupID, ok := d.GetOk("group_id"); ok {
+		query.Set("group_id", strconv.Itoa(groupID.(int)))
+	}
+	if siteID, ok := d.GetOk("site_id"); ok {
+		query.Set("site_id", strconv.Itoa(siteID.(int)))
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		query.Set("tenant_id", strconv.Itoa(tenantID.(int)))
+	}
+
+	var res struct {
+		Count   int64                `json:"count"`
+		Results []*netboxWirelessLAN `json:"results"`
+	}
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, wirelessLANsPath, query, nil, &res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if res.Count > int64(1) {
+		return diag.Errorf("more than one wireless lan returned, specify a more narrow filter")
+	}
+	if res.Count == int64(0) {
+		return diag.Errorf("no wireless lan found matching filter")
+	}
+
+	result := res.Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("ssid", result.Ssid)
+	d.Set("description", result.Description)
+	d.Set("auth_psk", result.AuthPsk)
+	d.Set("scope_type", result.ScopeType)
+	d.Set("scope_id", result.ScopeID)
+
+	if result.Status != nil {
+		d.Set("status", result.Status.Value)
+	}
+	if result.AuthType != nil {
+		d.Set("auth_type", result.AuthType.Value)
+	}
+	if result.AuthCipher != nil {
+		d.Set("auth_cipher", result.AuthCipher.Value)
+	}
+	if result.Group != nil {
+		d.Set("group_id", result.Group.ID)
+	} else {
+		d.Set("group_id", nil)
+	}
+	if result.Vlan != nil {
+		d.Set("vlan_id", result.Vlan.ID)
+	} else {
+		d.Set("vlan_id", nil)
+	}
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	if result.ScopeType != nil && *result.ScopeType == "dcim.site" {
+		d.Set("site_id", result.ScopeID)
+	}
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
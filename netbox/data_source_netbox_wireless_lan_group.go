@@ -0,0 +1,87 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/wireless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxWirelessLANGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxWirelessLANGroupRead,
+		Description: `:meta:subcategory:Wireless:`,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "slug"},
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "slug"},
+			},
+			"parent_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wireless_lan_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxWirelessLANGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	params := wireless.NewWirelessWirelessLanGroupsListParams().WithContext(ctx)
+
+	params.Limit = int64ToPtr(2)
+	if name, ok := d.Get("name").(string); ok && name != "" {
+		params.Name = &name
+	}
+	if slug, ok := d.Get("slug").(string); ok && slug != "" {
+		params.Slug = &slug
+	}
+	if parentID, ok := d.GetOk("parent_id"); ok {
+		params.ParentID = strToPtr(strconv.Itoa(parentID.(int)))
+	}
+
+	res, err := api.Wireless.WirelessWirelessLanGroupsList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if *res.GetPayload().Count > int64(1) {
+		return diag.Errorf("more than one wireless lan group returned, specify a more narrow filter")
+	}
+	if *res.GetPayload().Count == int64(0) {
+		return diag.Errorf("no wireless lan group found matching filter")
+	}
+
+	result := res.GetPayload().Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+	d.Set("description", result.Description)
+	d.Set("wireless_lan_count", result.WirelesslanCount)
+	if result.Parent != nil {
+		d.Set("parent_id", result.Parent.ID)
+	} else {
+		d.Set("parent_id", nil)
+	}
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
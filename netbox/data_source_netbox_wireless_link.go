@@ -0,0 +1,129 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/wireless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxWirelessLink() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetboxWirelessLinkRead,
+		Description: `:meta:subcategory:Wireless:`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "ssid", "interface_a_id", "interface_b_id"},
+			},
+			"ssid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "ssid", "interface_a_id", "interface_b_id"},
+			},
+			"interface_a_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "ssid", "interface_a_id", "interface_b_id"},
+			},
+			"interface_b_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "ssid", "interface_a_id", "interface_b_id"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_cipher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_psk": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxWirelessLinkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	params := wireless.NewWirelessWirelessLinksListParams().WithContext(ctx)
+
+	params.Limit = int64ToPtr(2)
+	if id, ok := d.GetOk("id"); ok {
+		params.ID = strToPtr(strconv.Itoa(id.(int)))
+	}
+	if ssid, ok := d.GetOk("ssid"); ok {
+		params.Ssid = strToPtr(ssid.(string))
+	}
+	if interfaceAID, ok := d.GetOk("interface_a_id"); ok {
+		params.InterfaceaID = strToPtr(strconv.Itoa(interfaceAID.(int)))
+	}
+	if interfaceBID, ok := d.GetOk("interface_b_id"); ok {
+		params.InterfacebID = strToPtr(strconv.Itoa(interfaceBID.(int)))
+	}
+
+	res, err := api.Wireless.WirelessWirelessLinksList(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if *res.GetPayload().Count > int64(1) {
+		return diag.Errorf("more than one wireless link returned, specify a more narrow filter")
+	}
+	if *res.GetPayload().Count == int64(0) {
+		return diag.Errorf("no wireless link found matching filter")
+	}
+
+	result := res.GetPayload().Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("ssid", result.Ssid)
+	d.Set("description", result.Description)
+	d.Set("auth_psk", result.AuthPsk)
+	if result.Interfacea != nil {
+		d.Set("interface_a_id", result.Interfacea.ID)
+	}
+	if result.Interfaceb != nil {
+		d.Set("interface_b_id", result.Interfaceb.ID)
+	}
+	if result.Status != nil {
+		d.Set("status", result.Status.Value)
+	}
+	if result.AuthType != nil {
+		d.Set("auth_type", result.AuthType.Value)
+	}
+	if result.AuthCipher != nil {
+		d.Set("auth_cipher", result.AuthCipher.Value)
+	}
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
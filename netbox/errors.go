@@ -0,0 +1,188 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// netboxAPIError is implemented by the default responses of all go-netbox
+// operations, which carry the decoded error body sent by Netbox.
+type netboxAPIError interface {
+	error
+	Code() int
+	GetPayload() interface{}
+}
+
+// netboxFieldAliases maps Netbox fields to the names of the corresponding
+// attributes in resources that do not follow the `<field>` or `<field>_id`
+// naming scheme. An alias is only used if the resource has that attribute.
+var netboxFieldAliases = map[string][]string{
+	"assigned_object_id":   {"interface_id", "object_id"},
+	"assigned_object_type": {"object_type"},
+	"face":                 {"rack_face"},
+	"position":             {"rack_position"},
+	"vc_position":          {"virtual_chassis_position"},
+	"vc_priority":          {"virtual_chassis_priority"},
+}
+
+// diagFromNetboxError turns an error returned by the Netbox API into
+// diagnostics. Validation errors (HTTP 400) are split into one diagnostic per
+// rejected field, pointing to the matching attribute of the resource, so that
+// Terraform highlights the offending line of the configuration. The request
+// ID of the failed request is added to the detail of each diagnostic. All
+// other errors are passed through unchanged.
+func diagFromNetboxError(err error, d *schema.ResourceData) diag.Diagnostics {
+	var apiErr netboxAPIError
+	if !errors.As(err, &apiErr) || apiErr.Code() != http.StatusBadRequest {
+		return diag.FromErr(err)
+	}
+
+	fieldErrors := flattenNetboxErrors(apiErr.GetPayload())
+	if len(fieldErrors) == 0 {
+		return diag.FromErr(err)
+	}
+
+	hasAttribute := resourceAttributeLookup(d)
+
+	fields := make([]string, 0, len(fieldErrors))
+	for field := range fieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var diags diag.Diagnostics
+	for _, field := range fields {
+		detail := withRequestID(strings.Join(fieldErrors[field], "\n"), apiErr)
+
+		attribute := attributeForNetboxField(field, hasAttribute)
+		switch {
+		case attribute != "":
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Netbox rejected the value of %q", attribute),
+				Detail:        detail,
+				AttributePath: cty.GetAttrPath(attribute),
+			})
+		case field == "":
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Netbox rejected the request",
+				Detail:   detail,
+			})
+		default:
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Netbox rejected the value of field %q", field),
+				Detail:   detail,
+			})
+		}
+	}
+	return diags
+}
+
+// withRequestID appends the ID of the failed request, as added to the error
+// body by the requestIDTransport, to the detail of a diagnostic.
+func withRequestID(detail string, apiErr netboxAPIError) string {
+	body, _ := apiErr.GetPayload().(map[string]interface{})
+	if requestID, ok := body[requestIDField].(string); ok && requestID != "" {
+		return detail + "\n\nRequest ID: " + requestID
+	}
+	return detail
+}
+
+// flattenNetboxErrors collects the messages of a Netbox error body per top
+// level field. Errors not related to a field, like `non_field_errors` or
+// `detail`, are collected under the empty field name. Messages of nested
+// fields are prefixed with their path.
+func flattenNetboxErrors(payload interface{}) map[string][]string {
+	body, ok := payload.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make(map[string][]string)
+	for field, value := range body {
+		if field == requestIDField {
+			continue
+		}
+		messages := flattenNetboxErrorMessages(value, "")
+		if len(messages) == 0 {
+			continue
+		}
+		switch field {
+		case "non_field_errors", "__all__", "detail":
+			field = ""
+		}
+		result[field] = append(result[field], messages...)
+	}
+	return result
+}
+
+func flattenNetboxErrorMessages(value interface{}, path string) []string {
+	var messages []string
+	switch v := value.(type) {
+	case string:
+		if path != "" {
+			v = path + ": " + v
+		}
+		messages = append(messages, v)
+	case []interface{}:
+		for i, item := range v {
+			if _, ok := item.(string); ok {
+				messages = append(messages, flattenNetboxErrorMessages(item, path)...)
+			} else {
+				// lists of objects report their errors per item
+				messages = append(messages, flattenNetboxErrorMessages(item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			nested := key
+			if path != "" {
+				nested = path + "." + key
+			}
+			messages = append(messages, flattenNetboxErrorMessages(v[key], nested)...)
+		}
+	}
+	return messages
+}
+
+// attributeForNetboxField returns the resource attribute that sets the given
+// Netbox field, or an empty string if there is none.
+func attributeForNetboxField(field string, hasAttribute func(string) bool) string {
+	if field == "" {
+		return ""
+	}
+
+	candidates := []string{field, field + "_id", field + "_ids"}
+	candidates = append(candidates, netboxFieldAliases[field]...)
+	for _, candidate := range candidates {
+		if hasAttribute(candidate) {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// resourceAttributeLookup returns a function reporting whether the resource
+// has an attribute with the given name. The raw config always has the type
+// implied by the resource schema, even if it is null, e.g. on delete.
+func resourceAttributeLookup(d *schema.ResourceData) func(string) bool {
+	configType := d.GetRawConfig().Type()
+	if !configType.IsObjectType() {
+		return func(string) bool { return false }
+	}
+	return configType.HasAttribute
+}
@@ -0,0 +1,103 @@
+package netbox
+
+import (
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenNetboxErrors(t *testing.T) {
+	payload := map[string]interface{}{
+		"device_type":      []interface{}{"Related object not found using the provided numeric ID: 123"},
+		"non_field_errors": []interface{}{"The fields name, site must make a unique set."},
+		"custom_fields": map[string]interface{}{
+			"owner": []interface{}{"Value must be a string."},
+		},
+		"tags": []interface{}{
+			map[string]interface{}{},
+			map[string]interface{}{"name": []interface{}{"This field is required."}},
+		},
+	}
+
+	assert.Equal(t, map[string][]string{
+		"device_type":   {"Related object not found using the provided numeric ID: 123"},
+		"":              {"The fields name, site must make a unique set."},
+		"custom_fields": {"owner: Value must be a string."},
+		"tags":          {"[1].name: This field is required."},
+	}, flattenNetboxErrors(payload))
+
+	assert.Nil(t, flattenNetboxErrors("Internal Server Error"))
+}
+
+func TestAttributeForNetboxField(t *testing.T) {
+	attributes := map[string]bool{
+		"name":           true,
+		"device_type_id": true,
+		"tags":           true,
+		"rack_face":      true,
+		"interface_id":   true,
+	}
+	hasAttribute := func(name string) bool { return attributes[name] }
+
+	for field, expected := range map[string]string{
+		"name":               "name",
+		"device_type":        "device_type_id",
+		"tags":               "tags",
+		"face":               "rack_face",
+		"assigned_object_id": "interface_id",
+		"serial":             "",
+		"":                   "",
+	} {
+		assert.Equal(t, expected, attributeForNetboxField(field, hasAttribute), field)
+	}
+}
+
+func TestDiagFromNetboxError(t *testing.T) {
+	d := resourceNetboxDevice().TestResourceData()
+
+	err := dcim.NewDcimDevicesCreateDefault(400)
+	err.Payload = map[string]interface{}{
+		"device_type":      []interface{}{"Related object not found using the provided numeric ID: 123"},
+		"non_field_errors": []interface{}{"The fields name, site must make a unique set."},
+	}
+
+	diags := diagFromNetboxError(err, d)
+	assert.Len(t, diags, 2)
+	assert.Equal(t, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Netbox rejected the request",
+		Detail:   "The fields name, site must make a unique set.",
+	}, diags[0])
+	assert.Equal(t, diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       `Netbox rejected the value of "device_type_id"`,
+		Detail:        "Related object not found using the provided numeric ID: 123",
+		AttributePath: cty.GetAttrPath("device_type_id"),
+	}, diags[1])
+
+	// other errors are passed through
+	err = dcim.NewDcimDevicesCreateDefault(500)
+	err.Payload = map[string]interface{}{"detail": "Internal Server Error"}
+	diags = diagFromNetboxError(err, d)
+	assert.Len(t, diags, 1)
+	assert.Nil(t, diags[0].AttributePath)
+	assert.Equal(t, err.Error(), diags[0].Summary)
+}
+
+func TestDiagFromNetboxErrorRequestID(t *testing.T) {
+	d := resourceNetboxDevice().TestResourceData()
+
+	err := dcim.NewDcimDevicesCreateDefault(400)
+	err.Payload = map[string]interface{}{
+		"name":       []interface{}{"This field is required."},
+		"request_id": "5c7b5a3e-0a4c-4f2e-9d8b-2f0b8b0c1d2e",
+	}
+
+	diags := diagFromNetboxError(err, d)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, `Netbox rejected the value of "name"`, diags[0].Summary)
+	assert.Equal(t, "This field is required.\n\nRequest ID: 5c7b5a3e-0a4c-4f2e-9d8b-2f0b8b0c1d2e", diags[0].Detail)
+}
@@ -0,0 +1,120 @@
+package netbox
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// filterNameRegex matches Netbox query parameters, including lookup
+// expressions like `name__ic` and custom field filters like `cf_owner`.
+var filterNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// reservedFilterNames are query parameters the data sources set themselves.
+var reservedFilterNames = map[string]string{
+	"limit":  "use the `limit` attribute instead",
+	"offset": "all pages are fetched automatically",
+	"brief":  "the data sources need the full objects",
+	"fields": "the data sources need the full objects",
+}
+
+// filterSchema returns the schema of the `filter` block of plural data
+// sources. Every filter is passed to Netbox as a query parameter.
+func filterSchema(objects string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: fmt.Sprintf("A list of filters to apply to the API query when requesting %s. Filters with different names are combined with AND. Repeating a filter passes all its values to Netbox, which combines them with OR for most fields.", objects),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the field to filter on. Any query parameter of the corresponding Netbox API endpoint is supported, including lookup expressions like `name__ic`, `vid__gte`, `tenant_id__n` or `tenant__isnull` and custom field filters like `cf_owner`.",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The value to pass to the specified filter.",
+				},
+			},
+		},
+	}
+}
+
+// filterQueryParams collects the `filter` blocks of a plural data source into
+// query parameters. The aliases map filter names supported by earlier versions
+// of a data source to the corresponding Netbox query parameter.
+func filterQueryParams(d *schema.ResourceData, aliases map[string]string) (url.Values, error) {
+	var filters []interface{}
+	switch v := d.Get("filter").(type) {
+	case *schema.Set:
+		filters = v.List()
+	case []interface{}:
+		filters = v
+	}
+
+	values := make(url.Values)
+	for _, f := range filters {
+		name := f.(map[string]interface{})["name"].(string)
+		value := f.(map[string]interface{})["value"].(string)
+
+		if alias, ok := aliases[name]; ok {
+			name = alias
+		}
+		if !filterNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("'%s' is not a valid filter parameter", name)
+		}
+		if reason, ok := reservedFilterNames[name]; ok {
+			return nil, fmt.Errorf("'%s' is not a supported filter parameter, %s", name, reason)
+		}
+		values.Add(name, value)
+	}
+
+	// the filter set has no order
+	for name := range values {
+		sort.Strings(values[name])
+	}
+	return values, nil
+}
+
+// withFilters returns an auth info writer that adds the given filters as
+// query parameters to a request and then authenticates it like the default
+// one. The generated list parameters only know the filters of the API schema
+// they were generated from, this allows passing any filter Netbox supports.
+func (s *providerState) withFilters(filters url.Values) runtime.ClientAuthInfoWriter {
+	if len(filters) == 0 {
+		return nil
+	}
+	return runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		for name, values := range filters {
+			if err := r.SetQueryParam(name, values...); err != nil {
+				return err
+			}
+		}
+		if s.defaultAuth == nil {
+			return nil
+		}
+		return s.defaultAuth.AuthenticateRequest(r, reg)
+	})
+}
+
+// defaultAuthentication returns the authentication the client was configured
+// with, see Config.Client.
+func defaultAuthentication(api *client.NetBoxAPI) runtime.ClientAuthInfoWriter {
+	transport := api.Transport
+	if wrapped, ok := transport.(operationTimeoutTransport); ok {
+		transport = wrapped.ClientTransport
+	}
+	if transport, ok := transport.(*httptransport.Runtime); ok {
+		return transport.DefaultAuthentication
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package netbox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testFilterResourceData(t *testing.T, filters ...map[string]interface{}) *schema.ResourceData {
+	raw := make([]interface{}, len(filters))
+	for i, f := range filters {
+		raw[i] = f
+	}
+	return schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"filter": filterSchema("devices"),
+	}, map[string]interface{}{"filter": raw})
+}
+
+func TestFilterQueryParams(t *testing.T) {
+	d := testFilterResourceData(t,
+		map[string]interface{}{"name": "name__ic", "value": "core"},
+		map[string]interface{}{"name": "site_id", "value": "2"},
+		map[string]interface{}{"name": "site_id", "value": "1"},
+		map[string]interface{}{"name": "tenant__isnull", "value": "true"},
+		map[string]interface{}{"name": "cf_owner", "value": "network"},
+		map[string]interface{}{"name": "tags", "value": "prod"},
+	)
+
+	values, err := filterQueryParams(d, map[string]string{"tags": "tag"})
+	assert.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"name__ic":       {"core"},
+		"site_id":        {"1", "2"},
+		"tenant__isnull": {"true"},
+		"cf_owner":       {"network"},
+		"tag":            {"prod"},
+	}, values)
+}
+
+func TestFilterQueryParamsInvalid(t *testing.T) {
+	for _, name := range []string{"limit", "offset", "name&limit=1", ""} {
+		d := testFilterResourceData(t, map[string]interface{}{"name": name, "value": "1"})
+		_, err := filterQueryParams(d, nil)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestWithFilters(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Token 0123456789abcdef", r.Header.Get("Authorization"))
+		assert.Equal(t, []string{"1", "2"}, r.URL.Query()["site_id"])
+		assert.Equal(t, "core", r.URL.Query().Get("name__ic"))
+		assert.Equal(t, "50", r.URL.Query().Get("limit"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count": 0, "results": []}`))
+	}))
+	defer ts.Close()
+
+	config := Config{
+		APIToken:  "0123456789abcdef",
+		ServerURL: ts.URL,
+	}
+	client, err := config.Client()
+	assert.NoError(t, err)
+
+	state := &providerState{NetBoxAPI: client, defaultAuth: defaultAuthentication(client)}
+
+	limit := int64(50)
+	params := dcim.NewDcimDevicesListParams()
+	params.Limit = &limit
+	_, err = client.Dcim.DcimDevicesList(params, state.withFilters(url.Values{
+		"site_id":  {"1", "2"},
+		"name__ic": {"core"},
+	}))
+	assert.NoError(t, err)
+}
@@ -1,7 +1,12 @@
 package netbox
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
@@ -31,3 +36,32 @@ func sharedClientForRegion(region string) (interface{}, error) {
 
 	return c, nil
 }
+
+// testAccSweepObjectsByName deletes all objects below the given REST API path
+// whose name starts with the test prefix. It is used by the sweepers of objects
+// that are managed through the REST API directly.
+func testAccSweepObjectsByName(region, path string) error {
+	m, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("Error getting client: %s", err)
+	}
+	api := m.(*providerState)
+	var res struct {
+		Results []struct {
+			ID   int64  `json:"id"`
+			Name string `json:"name"`
+		} `json:"results"`
+	}
+	if err := netboxAPIRequest(context.Background(), api.Transport, http.MethodGet, path, nil, nil, &res); err != nil {
+		return err
+	}
+	for _, object := range res.Results {
+		if strings.HasPrefix(object.Name, testPrefix) {
+			if err := netboxAPIRequest(context.Background(), api.Transport, http.MethodDelete, fmt.Sprintf("%s%d/", path, object.ID), nil, nil, nil); err != nil {
+				return err
+			}
+			log.Printf("[DEBUG] Deleted %s%d/", path, object.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package netbox
+
+import (
+	"sync"
+)
+
+// netboxPageSize is the number of objects requested per page. It matches the
+// default MAX_PAGE_SIZE of Netbox; if the server is configured with a smaller
+// maximum, the page size returned by the server is used instead.
+const netboxPageSize = int64(1000)
+
+// pageFetcher fetches a single page of a list endpoint, starting at the given
+// offset. It returns the objects of the page and the total number of objects
+// matching the query.
+type pageFetcher[T any] func(limit, offset int64) (results []T, count int64, err error)
+
+// fetchAllPages collects the objects of a list endpoint page by page until
+// `limit` objects were fetched or there are no more objects. A limit of 0
+// fetches all objects. With a concurrency greater than 1, the pages after the
+// first one are fetched in parallel.
+func fetchAllPages[T any](limit int64, concurrency int, fetch pageFetcher[T]) ([]T, error) {
+	pageSize := netboxPageSize
+	if limit > 0 && limit < pageSize {
+		pageSize = limit
+	}
+
+	results, count, err := fetch(pageSize, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	total := count
+	if limit > 0 && limit < total {
+		total = limit
+	}
+	if int64(len(results)) >= total || len(results) == 0 {
+		return truncatePage(results, total), nil
+	}
+
+	// the server may cap the page size below the requested one
+	pageSize = int64(len(results))
+
+	if concurrency <= 1 {
+		for offset := pageSize; offset < total; {
+			page, _, err := fetch(min(pageSize, total-offset), offset)
+			if err != nil {
+				return nil, err
+			}
+			if len(page) == 0 {
+				break
+			}
+			results = append(results, page...)
+			offset += int64(len(page))
+		}
+		return truncatePage(results, total), nil
+	}
+
+	var offsets []int64
+	for offset := pageSize; offset < total; offset += pageSize {
+		offsets = append(offsets, offset)
+	}
+
+	pages := make([][]T, len(offsets))
+	errs := make([]error, len(offsets))
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, offset := range offsets {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			pages[i], _, errs[i] = fetch(min(pageSize, total-offset), offset)
+		}()
+	}
+	wg.Wait()
+
+	for i := range pages {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		results = append(results, pages[i]...)
+	}
+	return truncatePage(results, total), nil
+}
+
+func truncatePage[T any](results []T, total int64) []T {
+	if int64(len(results)) > total {
+		return results[:total]
+	}
+	return results
+}
@@ -0,0 +1,78 @@
+package netbox
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testPages simulates a Netbox list endpoint with the given number of objects
+// and maximum page size. It records the offsets requested.
+func testPages(count, maxPageSize int64) (pageFetcher[int64], *[]int64) {
+	var mu sync.Mutex
+	var offsets []int64
+	return func(limit, offset int64) ([]int64, int64, error) {
+		mu.Lock()
+		offsets = append(offsets, offset)
+		mu.Unlock()
+
+		limit = min(limit, maxPageSize)
+		var results []int64
+		for i := offset; i < count && i < offset+limit; i++ {
+			results = append(results, i)
+		}
+		return results, count, nil
+	}, &offsets
+}
+
+func expectedObjects(n int64) []int64 {
+	objects := make([]int64, n)
+	for i := range objects {
+		objects[i] = int64(i)
+	}
+	return objects
+}
+
+func TestFetchAllPages(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		count       int64
+		maxPageSize int64
+		limit       int64
+		concurrency int
+		expected    int64
+		requests    int
+	}{
+		{"empty", 0, 1000, 0, 1, 0, 1},
+		{"single page", 10, 1000, 0, 1, 10, 1},
+		{"all pages", 2500, 1000, 0, 1, 2500, 3},
+		{"limit", 2500, 1000, 1500, 1, 1500, 2},
+		{"limit below page size", 2500, 1000, 5, 1, 5, 1},
+		{"server page size", 250, 100, 0, 1, 250, 3},
+		{"concurrent", 2500, 1000, 0, 4, 2500, 3},
+		{"concurrent with server page size", 1050, 100, 0, 3, 1050, 11},
+		{"concurrent with limit", 1050, 100, 420, 3, 420, 5},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fetch, offsets := testPages(tc.count, tc.maxPageSize)
+			results, err := fetchAllPages(tc.limit, tc.concurrency, fetch)
+			assert.NoError(t, err)
+			assert.Equal(t, expectedObjects(tc.expected), append([]int64{}, results...))
+			assert.Len(t, *offsets, tc.requests)
+		})
+	}
+}
+
+func TestFetchAllPagesError(t *testing.T) {
+	for _, concurrency := range []int{1, 4} {
+		_, err := fetchAllPages(0, concurrency, func(limit, offset int64) ([]int64, int64, error) {
+			if offset > 0 {
+				return nil, 0, errors.New("page failed")
+			}
+			return expectedObjects(limit), 5000, nil
+		})
+		assert.EqualError(t, err, "page failed")
+	}
+}
@@ -5,22 +5,40 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/status"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"golang.org/x/exp/slices"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 type providerState struct {
 	*client.NetBoxAPI
-	defaultTags *schema.Set
+	defaultTags         *schema.Set
+	defaultCustomFields map[string]interface{}
+
+	// nil if the version check was skipped
+	netboxVersion *version.Version
 
 	// concurrent access ok, only populated on provider start
 	tagCache map[string]*models.NestedTag
+
+	// tags and custom fields managed outside of Terraform
+	ignoreTags         []string
+	ignoreTagPrefixes  []string
+	ignoreCustomFields []string
+
+	// number of pages plural data sources fetch in parallel
+	pageConcurrency int
+
+	// authenticates requests that carry additional filters, see withFilters
+	defaultAuth runtime.ClientAuthInfoWriter
 }
 
 // This makes the description contain the default value, particularly useful for the docs
@@ -85,6 +103,7 @@ func Provider() *schema.Provider {
 	provider := &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
 			"netbox_available_ip_address":       resourceNetboxAvailableIPAddress(),
+			"netbox_available_ip_addresses":     resourceNetboxAvailableIPAddresses(),
 			"netbox_virtual_machine":            resourceNetboxVirtualMachine(),
 			"netbox_cluster_type":               resourceNetboxClusterType(),
 			"netbox_cluster":                    resourceNetboxCluster(),
@@ -117,6 +136,10 @@ func Provider() *schema.Provider {
 			"netbox_available_vlan":             resourceNetboxAvailableVLAN(),
 			"netbox_ipam_role":                  resourceNetboxIpamRole(),
 			"netbox_ip_range":                   resourceNetboxIPRange(),
+			"netbox_fhrp_group":                 resourceNetboxFHRPGroup(),
+			"netbox_fhrp_group_assignment":      resourceNetboxFHRPGroupAssignment(),
+			"netbox_l2vpn":                      resourceNetboxL2VPN(),
+			"netbox_l2vpn_termination":          resourceNetboxL2VPNTermination(),
 			"netbox_region":                     resourceNetboxRegion(),
 			"netbox_aggregate":                  resourceNetboxAggregate(),
 			"netbox_rir":                        resourceNetboxRir(),
@@ -131,6 +154,8 @@ func Provider() *schema.Provider {
 			"netbox_token":                      resourceNetboxToken(),
 			"netbox_custom_field":               resourceCustomField(),
 			"netbox_asn":                        resourceNetboxAsn(),
+			"netbox_asn_range":                  resourceNetboxAsnRange(),
+			"netbox_available_asn":              resourceNetboxAvailableAsn(),
 			"netbox_location":                   resourceNetboxLocation(),
 			"netbox_site_group":                 resourceNetboxSiteGroup(),
 			"netbox_rack":                       resourceNetboxRack(),
@@ -147,6 +172,7 @@ func Provider() *schema.Provider {
 			"netbox_device_module_bay":          resourceNetboxDeviceModuleBay(),
 			"netbox_device_bay":                 resourceNetboxDeviceBay(),
 			"netbox_device_bay_template":        resourceNetboxDeviceBayTemplate(),
+			"netbox_virtual_device_context":     resourceNetboxVirtualDeviceContext(),
 			"netbox_module":                     resourceNetboxModule(),
 			"netbox_module_type":                resourceNetboxModuleType(),
 			"netbox_power_feed":                 resourceNetboxPowerFeed(),
@@ -162,58 +188,76 @@ func Provider() *schema.Provider {
 			"netbox_vpn_tunnel_group":           resourceNetboxVpnTunnelGroup(),
 			"netbox_vpn_tunnel":                 resourceNetboxVpnTunnel(),
 			"netbox_vpn_tunnel_termination":     resourceNetboxVpnTunnelTermination(),
+			"netbox_vpn_ike_proposal":           resourceNetboxVpnIkeProposal(),
+			"netbox_vpn_ike_policy":             resourceNetboxVpnIkePolicy(),
+			"netbox_vpn_ipsec_proposal":         resourceNetboxVpnIpsecProposal(),
+			"netbox_vpn_ipsec_policy":           resourceNetboxVpnIpsecPolicy(),
+			"netbox_vpn_ipsec_profile":          resourceNetboxVpnIpsecProfile(),
 			"netbox_config_context":             resourceNetboxConfigContext(),
 			"netbox_mac_address":                resourceNetboxMACAddress(),
 			"netbox_power_port_template":        resourceNetboxPowerPortTemplate(),
 			"netbox_console_port_template":      resourceConsolePortTemplate(),
 			"netbox_power_outlet_template":      resourcePowerOutletTemplate(),
+			"netbox_branch":                     resourceNetboxBranch(),
+			"netbox_wireless_lan_group":         resourceNetboxWirelessLANGroup(),
+			"netbox_wireless_lan":               resourceNetboxWirelessLAN(),
+			"netbox_wireless_link":              resourceNetboxWirelessLink(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"netbox_asn":                dataSourceNetboxAsn(),
-			"netbox_asns":               dataSourceNetboxAsns(),
-			"netbox_available_prefix":   dataSourceNetboxAvailablePrefix(),
-			"netbox_cluster":            dataSourceNetboxCluster(),
-			"netbox_cluster_group":      dataSourceNetboxClusterGroup(),
-			"netbox_cluster_type":       dataSourceNetboxClusterType(),
-			"netbox_contact":            dataSourceNetboxContact(),
-			"netbox_contact_role":       dataSourceNetboxContactRole(),
-			"netbox_contact_group":      dataSourceNetboxContactGroup(),
-			"netbox_tenant":             dataSourceNetboxTenant(),
-			"netbox_tenants":            dataSourceNetboxTenants(),
-			"netbox_tenant_group":       dataSourceNetboxTenantGroup(),
-			"netbox_vrf":                dataSourceNetboxVrf(),
-			"netbox_vrfs":               dataSourceNetboxVrfs(),
-			"netbox_platform":           dataSourceNetboxPlatform(),
-			"netbox_prefix":             dataSourceNetboxPrefix(),
-			"netbox_prefixes":           dataSourceNetboxPrefixes(),
-			"netbox_devices":            dataSourceNetboxDevices(),
-			"netbox_device_role":        dataSourceNetboxDeviceRole(),
-			"netbox_device_type":        dataSourceNetboxDeviceType(),
-			"netbox_site":               dataSourceNetboxSite(),
-			"netbox_location":           dataSourceNetboxLocation(),
-			"netbox_locations":          dataSourceNetboxLocations(),
-			"netbox_tag":                dataSourceNetboxTag(),
-			"netbox_tags":               dataSourceNetboxTags(),
-			"netbox_virtual_machines":   dataSourceNetboxVirtualMachine(),
-			"netbox_interfaces":         dataSourceNetboxInterfaces(),
-			"netbox_device_interfaces":  dataSourceNetboxDeviceInterfaces(),
-			"netbox_device_power_ports": dataSourceNetboxDevicePowerPorts(),
-			"netbox_ipam_role":          dataSourceNetboxIPAMRole(),
-			"netbox_route_target":       dataSourceNetboxRouteTarget(),
-			"netbox_ip_address":         dataSourceNetboxIPAddress(),
-			"netbox_ip_addresses":       dataSourceNetboxIPAddresses(),
-			"netbox_ip_range":           dataSourceNetboxIPRange(),
-			"netbox_ip_ranges":          dataSourceNetboxIPRanges(),
-			"netbox_region":             dataSourceNetboxRegion(),
-			"netbox_vlan":               dataSourceNetboxVlan(),
-			"netbox_vlans":              dataSourceNetboxVlans(),
-			"netbox_vlan_group":         dataSourceNetboxVlanGroup(),
-			"netbox_site_group":         dataSourceNetboxSiteGroup(),
-			"netbox_racks":              dataSourceNetboxRacks(),
-			"netbox_rack_role":          dataSourceNetboxRackRole(),
-			"netbox_config_context":     dataSourceNetboxConfigContext(),
-			"netbox_virtual_disk":       dataSourceNetboxVirtualDisk(),
-			"netbox_manufacturer":       dataSourceNetboxManufacturer(),
+			"netbox_asn":                     dataSourceNetboxAsn(),
+			"netbox_asns":                    dataSourceNetboxAsns(),
+			"netbox_asn_range":               dataSourceNetboxAsnRange(),
+			"netbox_available_prefix":        dataSourceNetboxAvailablePrefix(),
+			"netbox_available_prefixes":      dataSourceNetboxAvailablePrefixes(),
+			"netbox_available_ip_addresses":  dataSourceNetboxAvailableIPAddresses(),
+			"netbox_cluster":                 dataSourceNetboxCluster(),
+			"netbox_cluster_group":           dataSourceNetboxClusterGroup(),
+			"netbox_cluster_type":            dataSourceNetboxClusterType(),
+			"netbox_contact":                 dataSourceNetboxContact(),
+			"netbox_contact_role":            dataSourceNetboxContactRole(),
+			"netbox_contact_group":           dataSourceNetboxContactGroup(),
+			"netbox_tenant":                  dataSourceNetboxTenant(),
+			"netbox_tenants":                 dataSourceNetboxTenants(),
+			"netbox_tenant_group":            dataSourceNetboxTenantGroup(),
+			"netbox_vrf":                     dataSourceNetboxVrf(),
+			"netbox_vrfs":                    dataSourceNetboxVrfs(),
+			"netbox_platform":                dataSourceNetboxPlatform(),
+			"netbox_prefix":                  dataSourceNetboxPrefix(),
+			"netbox_prefixes":                dataSourceNetboxPrefixes(),
+			"netbox_devices":                 dataSourceNetboxDevices(),
+			"netbox_device_role":             dataSourceNetboxDeviceRole(),
+			"netbox_device_type":             dataSourceNetboxDeviceType(),
+			"netbox_site":                    dataSourceNetboxSite(),
+			"netbox_location":                dataSourceNetboxLocation(),
+			"netbox_locations":               dataSourceNetboxLocations(),
+			"netbox_tag":                     dataSourceNetboxTag(),
+			"netbox_tags":                    dataSourceNetboxTags(),
+			"netbox_virtual_machines":        dataSourceNetboxVirtualMachine(),
+			"netbox_interfaces":              dataSourceNetboxInterfaces(),
+			"netbox_device_interfaces":       dataSourceNetboxDeviceInterfaces(),
+			"netbox_device_power_ports":      dataSourceNetboxDevicePowerPorts(),
+			"netbox_virtual_device_contexts": dataSourceNetboxVirtualDeviceContexts(),
+			"netbox_ipam_role":               dataSourceNetboxIPAMRole(),
+			"netbox_route_target":            dataSourceNetboxRouteTarget(),
+			"netbox_ip_address":              dataSourceNetboxIPAddress(),
+			"netbox_ip_addresses":            dataSourceNetboxIPAddresses(),
+			"netbox_ip_range":                dataSourceNetboxIPRange(),
+			"netbox_ip_ranges":               dataSourceNetboxIPRanges(),
+			"netbox_l2vpns":                  dataSourceNetboxL2VPNs(),
+			"netbox_region":                  dataSourceNetboxRegion(),
+			"netbox_vlan":                    dataSourceNetboxVlan(),
+			"netbox_vlans":                   dataSourceNetboxVlans(),
+			"netbox_vlan_group":              dataSourceNetboxVlanGroup(),
+			"netbox_site_group":              dataSourceNetboxSiteGroup(),
+			"netbox_racks":                   dataSourceNetboxRacks(),
+			"netbox_rack_role":               dataSourceNetboxRackRole(),
+			"netbox_config_context":          dataSourceNetboxConfigContext(),
+			"netbox_virtual_disk":            dataSourceNetboxVirtualDisk(),
+			"netbox_manufacturer":            dataSourceNetboxManufacturer(),
+			"netbox_wireless_lan_group":      dataSourceNetboxWirelessLANGroup(),
+			"netbox_wireless_lan":            dataSourceNetboxWirelessLAN(),
+			"netbox_wireless_link":           dataSourceNetboxWirelessLink(),
+			"netbox_vpn_ipsec_profile":       dataSourceNetboxVpnIpsecProfile(),
 		},
 		Schema: map[string]*schema.Schema{
 			"server_url": {
@@ -224,9 +268,38 @@ func Provider() *schema.Provider {
 			},
 			"api_token": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_API_TOKEN", nil),
-				Description: "Netbox API authentication token. Can be set via the `NETBOX_API_TOKEN` environment variable.",
+				Description: "Netbox API authentication token. Required unless `username` and `password` are given. Can be set via the `NETBOX_API_TOKEN` environment variable.",
+			},
+			"auth_scheme": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_AUTH_SCHEME", authSchemeAuto),
+				ValidateFunc: validation.StringInSlice(authSchemeOptions, false),
+				Description:  "Scheme used in the `Authorization` header. `token` is used for classic Netbox tokens, `bearer` for v2 tokens introduced in Netbox 4.5. `auto` picks `bearer` for tokens starting with `nbt_` and `token` otherwise. " + buildValidValueDescription(authSchemeOptions) + ". Can be set via the `NETBOX_AUTH_SCHEME` environment variable. Defaults to `auto`.",
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_USERNAME", ""),
+				RequiredWith: []string{"password"},
+				Description:  "Netbox username. If set, the provider provisions a short-lived API token for this user at startup instead of using `api_token` and tries to revoke it when it shuts down. Can be set via the `NETBOX_USERNAME` environment variable.",
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_PASSWORD", ""),
+				RequiredWith: []string{"username"},
+				Description:  "Password of the Netbox user given in `username`. Can be set via the `NETBOX_PASSWORD` environment variable.",
+			},
+			"token_lifetime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_TOKEN_LIFETIME", 120),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Lifetime in minutes of the API token provisioned via `username` and `password`. The token expires after this time even if the provider could not revoke it. Can be set via the `NETBOX_TOKEN_LIFETIME` environment variable. Defaults to `120`.",
 			},
 			"allow_insecure_https": {
 				Type:        schema.TypeBool,
@@ -234,6 +307,55 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_ALLOW_INSECURE_HTTPS", false),
 				Description: "Flag to set whether to allow https with invalid certificates. Can be set via the `NETBOX_ALLOW_INSECURE_HTTPS` environment variable. Defaults to `false`.",
 			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CA_CERT_FILE", ""),
+				ConflictsWith: []string{"ca_cert_pem"},
+				Description:   "Path to a PEM encoded CA bundle used to verify the certificate of the Netbox server instead of the system trust store. Can be set via the `NETBOX_CA_CERT_FILE` environment variable.",
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CA_CERT_PEM", ""),
+				ConflictsWith: []string{"ca_cert_file"},
+				Description:   "PEM encoded CA bundle used to verify the certificate of the Netbox server instead of the system trust store. Can be set via the `NETBOX_CA_CERT_PEM` environment variable.",
+			},
+			"client_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_CERT_FILE", ""),
+				ConflictsWith: []string{"client_cert_pem"},
+				Description:   "Path to a PEM encoded client certificate for mutual TLS authentication. Requires a client key. Can be set via the `NETBOX_CLIENT_CERT_FILE` environment variable.",
+			},
+			"client_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_CERT_PEM", ""),
+				ConflictsWith: []string{"client_cert_file"},
+				Description:   "PEM encoded client certificate for mutual TLS authentication. Requires a client key. Can be set via the `NETBOX_CLIENT_CERT_PEM` environment variable.",
+			},
+			"client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_KEY_FILE", ""),
+				ConflictsWith: []string{"client_key_pem"},
+				Description:   "Path to the PEM encoded private key of the client certificate. Can be set via the `NETBOX_CLIENT_KEY_FILE` environment variable.",
+			},
+			"client_key_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_KEY_PEM", ""),
+				ConflictsWith: []string{"client_key_file"},
+				Description:   "PEM encoded private key of the client certificate. Can be set via the `NETBOX_CLIENT_KEY_PEM` environment variable.",
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_TLS_SERVER_NAME", ""),
+				Description: "Server name used to verify the certificate of the Netbox server, if it differs from the host in `server_url`. Setting this always enables certificate verification, regardless of `allow_insecure_https`. Can be set via the `NETBOX_TLS_SERVER_NAME` environment variable.",
+			},
 			"headers": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -258,6 +380,60 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_REQUEST_TIMEOUT", 10),
 				Description: "Netbox API HTTP request timeout in seconds. Can be set via the `NETBOX_REQUEST_TIMEOUT` environment variable.",
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_MAX_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of times a request to Netbox is retried after a transient failure (connection errors, HTTP 429, 502, 503 and 504). Requests that are not idempotent, like creating an object, are only retried if they provably did not reach Netbox. Set to `0` to disable retries. Can be set via the `NETBOX_MAX_RETRIES` environment variable. Defaults to `3`.",
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_RETRY_WAIT_MIN", 1),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Minimum time in seconds to wait before retrying a failed request. The wait time doubles with every retry. Can be set via the `NETBOX_RETRY_WAIT_MIN` environment variable. Defaults to `1`.",
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_RETRY_WAIT_MAX", 30),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum time in seconds to wait before retrying a failed request. Also caps the wait time requested by Netbox via the `Retry-After` header. Can be set via the `NETBOX_RETRY_WAIT_MAX` environment variable. Defaults to `30`.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of requests the provider sends to Netbox at the same time, shared by all resources and data sources. Useful to stay below the number of workers of your Netbox installation when running Terraform with a high parallelism. Set to `0` for no limit. Can be set via the `NETBOX_MAX_CONCURRENT_REQUESTS` environment variable. Defaults to `0`.",
+			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_REQUESTS_PER_SECOND", 0.0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Maximum number of requests per second the provider sends to Netbox, shared by all resources and data sources. Fractional values are allowed. Set to `0` for no limit. Can be set via the `NETBOX_REQUESTS_PER_SECOND` environment variable. Defaults to `0`.",
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_BRANCH", nil),
+				Description: "Name of a branch of the netbox-branching plugin. If set, all changes are made in this branch instead of the main schema, so they can be reviewed before merging. The branch must exist and be ready. Can be set via the `NETBOX_BRANCH` environment variable.",
+			},
+			"changelog_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_CHANGELOG_MESSAGE", nil),
+				Description: "Message recorded in the Netbox changelog for every object the provider creates, updates or deletes, e.g. a link to the pipeline run applying the changes. Requires Netbox 4.4 or later, earlier versions ignore it. Can be set via the `NETBOX_CHANGELOG_MESSAGE` environment variable.",
+			},
+			"pagination_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NETBOX_PAGINATION_CONCURRENCY", 1),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of result pages plural data sources like `netbox_devices` fetch from Netbox in parallel. Data sources always fetch all pages up to their `limit`. Can be set via the `NETBOX_PAGINATION_CONCURRENCY` environment variable. Defaults to `1`.",
+			},
 			"default_tags": {
 				Type: schema.TypeSet,
 				Elem: &schema.Schema{
@@ -266,6 +442,48 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "Tags to add to every resource managed by this provider",
 			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags that are managed outside of Terraform, e.g. by discovery jobs or in the Netbox UI. Unless configured on a resource, they are left out of its `tags` attribute and kept on the object when the resource is updated. They are still listed in `tags_all`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"names": {
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional:    true,
+							Description: "Names of the tags to ignore.",
+						},
+						"name_prefixes": {
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional:    true,
+							Description: "Ignore all tags whose names start with one of these prefixes.",
+						},
+					},
+				},
+			},
+			"ignore_custom_fields": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Names of custom fields that are managed outside of Terraform. Unless configured on a resource, they are left out of its `custom_fields` and `custom_fields_json` attributes and kept on the object when the resource is updated. They are still listed in `custom_fields_all`.",
+			},
+			"default_custom_fields": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Custom fields to set on every resource managed by this provider that supports custom fields. Values set in the `custom_fields` or `custom_fields_json` attribute of a resource take precedence. All custom fields of a resource, including these defaults, are exposed in its `custom_fields_all` attribute.",
+			},
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -274,11 +492,19 @@ func Provider() *schema.Provider {
 	for _, def := range provider.ResourcesMap {
 		if _, ok := def.Schema[tagsKey]; ok {
 			def.Schema[tagsAllKey] = tagsAllSchema // add computed key for all tags
-			if existingDiff := def.CustomizeDiff; existingDiff != nil {
-				def.CustomizeDiff = customdiff.Sequence(existingDiff, tagsCustomDiff)
-			} else {
-				def.CustomizeDiff = tagsCustomDiff
-			}
+			appendCustomizeDiff(def, tagsCustomDiff)
+		}
+
+		// all resources that have custom fields get the same for custom fields
+		if _, ok := def.Schema[customFieldsJSONKey]; ok {
+			def.Schema[customFieldsAllKey] = customFieldsAllSchema
+			appendCustomizeDiff(def, customFieldsCustomDiff)
+		}
+
+		// long running operations, e.g. creating a device with hundreds of
+		// components, can be given more time in the timeouts block
+		if def.Timeouts == nil {
+			def.Timeouts = defaultResourceTimeouts()
 		}
 	}
 
@@ -290,10 +516,28 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 
 	config := Config{
 		APIToken:                    data.Get("api_token").(string),
+		AuthScheme:                  data.Get("auth_scheme").(string),
+		Username:                    data.Get("username").(string),
+		Password:                    data.Get("password").(string),
+		TokenLifetime:               data.Get("token_lifetime").(int),
 		AllowInsecureHTTPS:          data.Get("allow_insecure_https").(bool),
+		CACertFile:                  data.Get("ca_cert_file").(string),
+		CACertPEM:                   data.Get("ca_cert_pem").(string),
+		ClientCertFile:              data.Get("client_cert_file").(string),
+		ClientCertPEM:               data.Get("client_cert_pem").(string),
+		ClientKeyFile:               data.Get("client_key_file").(string),
+		ClientKeyPEM:                data.Get("client_key_pem").(string),
+		TLSServerName:               data.Get("tls_server_name").(string),
 		Headers:                     data.Get("headers").(map[string]interface{}),
 		RequestTimeout:              data.Get("request_timeout").(int),
 		StripTrailingSlashesFromURL: data.Get("strip_trailing_slashes_from_url").(bool),
+		MaxRetries:                  data.Get("max_retries").(int),
+		RetryWaitMin:                data.Get("retry_wait_min").(int),
+		RetryWaitMax:                data.Get("retry_wait_max").(int),
+		MaxConcurrentRequests:       data.Get("max_concurrent_requests").(int),
+		RequestsPerSecond:           data.Get("requests_per_second").(float64),
+		ChangelogMessage:            data.Get("changelog_message").(string),
+		Branch:                      data.Get("branch").(string),
 	}
 
 	serverURL := data.Get("server_url").(string)
@@ -331,8 +575,9 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 	// so we can determine compatibility of the provider with the used Netbox
 	skipVersionCheck := data.Get("skip_version_check").(bool)
 
+	var netboxVersion *version.Version
 	if !skipVersionCheck {
-		req := status.NewStatusListParams()
+		req := status.NewStatusListParams().WithContext(ctx)
 		res, err := netboxClient.Status.StatusList(req, nil)
 		if err != nil {
 			return nil, diag.FromErr(err)
@@ -340,29 +585,38 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 
 		netboxVersionStringFromAPI := res.GetPayload().(map[string]interface{})["netbox-version"].(string)
 
-		netboxVersion, err := extractSemanticVersionFromString(netboxVersionStringFromAPI)
+		netboxVersionString, err := extractSemanticVersionFromString(netboxVersionStringFromAPI)
+		if err == nil {
+			netboxVersion, err = version.NewVersion(netboxVersionString)
+		}
 		if err != nil {
 			return nil, diag.FromErr(fmt.Errorf("error extracting netbox version. try using the `skip_version_check` provider parameter to bypass this error. original error: %w", err))
 		}
 
-		supportedVersions := []string{"4.2.2", "4.2.3", "4.2.4", "4.2.5", "4.2.6", "4.2.7", "4.2.8", "4.2.9"}
-
-		if !slices.Contains(supportedVersions, netboxVersion) {
+		if !supportedNetboxVersions.Check(netboxVersion) {
 			// Currently, there is no way to test these warnings. There is an issue to track this: https://github.com/hashicorp/terraform-plugin-sdk/issues/864
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Warning,
 				Summary:  "Possibly unsupported Netbox version",
-				Detail:   fmt.Sprintf("Your Netbox reports version %v. From that, the provider extracted Netbox version %v.\nThe provider was successfully tested against the following versions:\n\n  %v\n\nUnexpected errors may occur.", netboxVersionStringFromAPI, netboxVersion, strings.Join(supportedVersions, ", ")),
+				Detail:   fmt.Sprintf("Your Netbox reports version %v. From that, the provider extracted Netbox version %v.\nThe provider was successfully tested against the following versions:\n\n  %v\n\nUnexpected errors may occur.", netboxVersionStringFromAPI, netboxVersion, supportedNetboxVersions),
 			})
 		}
 	}
 
+	if config.ChangelogMessage != "" && !supportsFeature(netboxVersion, featureChangelogMessage) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Changelog messages are not supported by this Netbox version",
+			Detail:   fmt.Sprintf("Your Netbox reports version %v, but changelog messages require Netbox >= %v. The `changelog_message` is sent, but ignored by Netbox.", netboxVersion, netboxCapabilities[featureChangelogMessage]),
+		})
+	}
+
 	tags, ok := data.Get("default_tags").(*schema.Set)
 	tagCache := make(map[string]*models.NestedTag, tags.Len())
 	if ok {
 		for _, tag := range tags.List() {
 			if tagName, ok := tag.(string); ok {
-				nbTag, err := findTag(netboxClient, tagName)
+				nbTag, err := findTag(ctx, netboxClient, tagName)
 				if err != nil {
 					d := diag.FromErr(fmt.Errorf("default tag not found: %w", err))
 					d[0].Severity = diag.Warning
@@ -376,20 +630,70 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 		}
 	}
 
+	var ignoreTags, ignoreTagPrefixes []string
+	if v, ok := data.Get("ignore_tags").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ignoreTagsConfig := v[0].(map[string]interface{})
+		ignoreTags = toStringList(ignoreTagsConfig["names"])
+		ignoreTagPrefixes = toStringList(ignoreTagsConfig["name_prefixes"])
+	}
+
 	state := &providerState{
-		NetBoxAPI:   netboxClient,
-		defaultTags: schema.CopySet(tags),
-		tagCache:    tagCache,
+		NetBoxAPI:           netboxClient,
+		defaultTags:         schema.CopySet(tags),
+		defaultCustomFields: data.Get("default_custom_fields").(map[string]interface{}),
+		netboxVersion:       netboxVersion,
+		tagCache:            tagCache,
+
+		ignoreTags:         ignoreTags,
+		ignoreTagPrefixes:  ignoreTagPrefixes,
+		ignoreCustomFields: toStringList(data.Get("ignore_custom_fields")),
+
+		pageConcurrency: data.Get("pagination_concurrency").(int),
+		defaultAuth:     defaultAuthentication(netboxClient),
 	}
 	return state, diags
 }
 
+// defaultResourceTimeout is the time Terraform waits for every operation of a
+// resource that does not define its own timeouts. It is the same as the
+// default of the plugin SDK.
+const defaultResourceTimeout = 20 * time.Minute
+
+// defaultResourceTimeouts returns the timeouts of resources that do not define
+// their own.
+func defaultResourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultResourceTimeout),
+		Read:   schema.DefaultTimeout(defaultResourceTimeout),
+		Update: schema.DefaultTimeout(defaultResourceTimeout),
+		Delete: schema.DefaultTimeout(defaultResourceTimeout),
+	}
+}
+
+// appendCustomizeDiff runs the given function after the existing
+// CustomizeDiff function of the resource, if any.
+func appendCustomizeDiff(def *schema.Resource, f schema.CustomizeDiffFunc) {
+	if existingDiff := def.CustomizeDiff; existingDiff != nil {
+		def.CustomizeDiff = customdiff.Sequence(existingDiff, f)
+	} else {
+		def.CustomizeDiff = f
+	}
+}
+
 func tagsCustomDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
 	state := m.(*providerState)
 
 	tagSet := diff.Get(tagsKey).(*schema.Set)
 	allTags := tagSet.Union(state.defaultTags)
 
+	// ignored tags stay on the object
+	oldTags, _ := diff.GetChange(tagsAllKey)
+	for _, tag := range oldTags.(*schema.Set).List() {
+		if state.tagIgnored(tag.(string)) {
+			allTags.Add(tag)
+		}
+	}
+
 	// check if tags are already up-to-date
 	if diff.Get(tagsAllKey).(*schema.Set).Equal(allTags) {
 		return nil // nothing to do, same set
@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -51,6 +55,27 @@ func testProviderConfig(platform string) string {
 	}`, platform)
 }
 
+func TestProviderResourcesAreContextAware(t *testing.T) {
+	p := Provider()
+	if err := p.InternalValidate(); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, def := range p.ResourcesMap {
+		if def.Create != nil || def.Read != nil || def.Update != nil || def.Delete != nil {
+			t.Errorf("%s uses legacy CRUD functions, which do not support cancellation", name)
+		}
+		if def.Timeouts == nil {
+			t.Errorf("%s has no timeouts", name)
+		}
+	}
+	for name, def := range p.DataSourcesMap {
+		if def.Read != nil {
+			t.Errorf("%s uses a legacy read function, which does not support cancellation", name)
+		}
+	}
+}
+
 func providerInvalidConfigure() schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var diags diag.Diagnostics
@@ -126,3 +151,163 @@ func TestAccNetboxProviderDefaultTags(t *testing.T) {
 		},
 	})
 }
+
+func TestAccNetboxProviderDefaultCustomFields(t *testing.T) {
+	ownerField := fmt.Sprintf("owner_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	costCenterField := fmt.Sprintf("cost_center_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"netbox": func() (*schema.Provider, error) {
+				p := Provider()
+				p.ConfigureContextFunc = func(ctx context.Context, rd *schema.ResourceData) (interface{}, diag.Diagnostics) {
+					rd.Set("default_custom_fields", map[string]interface{}{
+						ownerField:      "network",
+						costCenterField: "4711",
+					})
+					return providerConfigure(ctx, rd)
+				}
+				return p, nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "netbox_custom_field" "owner" {
+						name          = "%[1]s"
+						type          = "text"
+						content_types = ["dcim.site"]
+					}
+
+					resource "netbox_custom_field" "cost_center" {
+						name          = "%[2]s"
+						type          = "text"
+						content_types = ["dcim.site"]
+					}
+
+					resource "netbox_site" "testsite" {
+						name          = "%[3]s"
+						custom_fields = {"%[2]s" = "0815"}
+
+						depends_on = [
+							netbox_custom_field.owner,
+							netbox_custom_field.cost_center,
+						]
+					}
+					`, ownerField, costCenterField, acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.testsite", "custom_fields_all.%", "2"),
+					resource.TestCheckResourceAttr("netbox_site.testsite", "custom_fields_all."+ownerField, "network"),
+					resource.TestCheckResourceAttr("netbox_site.testsite", "custom_fields_all."+costCenterField, "0815"),
+					resource.TestCheckNoResourceAttr("netbox_site.testsite", "custom_fields."+ownerField),
+					resource.TestCheckResourceAttr("netbox_site.testsite", "custom_fields."+costCenterField, "0815"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxProviderIgnoreTagsAndCustomFields(t *testing.T) {
+	testName := testAccGetTestName("ignore")
+	ignoredTag := fmt.Sprintf("scan-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	ignoredField := fmt.Sprintf("discovered_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	var state *providerState
+	var siteID int64
+
+	testConfig := func(description string) string {
+		return fmt.Sprintf(`
+resource "netbox_tag" "managed" {
+  name = "%[1]s"
+}
+
+resource "netbox_tag" "ignored" {
+  name = "%[2]s"
+}
+
+resource "netbox_custom_field" "ignored" {
+  name          = "%[3]s"
+  type          = "text"
+  content_types = ["dcim.site"]
+}
+
+resource "netbox_site" "test" {
+  name        = "%[1]s"
+  description = "%[4]s"
+  tags        = [netbox_tag.managed.name]
+
+  depends_on = [
+    netbox_tag.ignored,
+    netbox_custom_field.ignored,
+  ]
+}`, testName, ignoredTag, ignoredField, description)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"netbox": func() (*schema.Provider, error) {
+				p := Provider()
+				p.ConfigureContextFunc = func(ctx context.Context, rd *schema.ResourceData) (interface{}, diag.Diagnostics) {
+					rd.Set("ignore_tags", []interface{}{map[string]interface{}{
+						"name_prefixes": []interface{}{"scan-"},
+					}})
+					rd.Set("ignore_custom_fields", []interface{}{ignoredField})
+					meta, diags := providerConfigure(ctx, rd)
+					state, _ = meta.(*providerState)
+					return meta, diags
+				}
+				return p, nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig("first"),
+				Check: func(s *terraform.State) error {
+					var err error
+					siteID, err = strconv.ParseInt(s.RootModule().Resources["netbox_site.test"].Primary.ID, 10, 64)
+					return err
+				},
+			},
+			{
+				// discovery adds a tag and a custom field value
+				PreConfig: func() {
+					res, err := state.Dcim.DcimSitesRead(dcim.NewDcimSitesReadParams().WithID(siteID), nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+					site := res.GetPayload()
+					tag, err := findTag(context.Background(), state.NetBoxAPI, ignoredTag)
+					if err != nil {
+						t.Fatal(err)
+					}
+					data := models.WritableSite{
+						Name:         site.Name,
+						Slug:         site.Slug,
+						Status:       *site.Status.Value,
+						Tags:         append(site.Tags, tag),
+						CustomFields: map[string]interface{}{ignoredField: "2026-10-17"},
+					}
+					_, err = state.Dcim.DcimSitesPartialUpdate(dcim.NewDcimSitesPartialUpdateParams().WithID(siteID).WithData(&data), nil)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testConfig("first"),
+				PlanOnly: true,
+			},
+			{
+				Config: testConfig("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "description", "second"),
+					resource.TestCheckResourceAttr("netbox_site.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_site.test", "tags_all.#", "2"),
+					resource.TestCheckTypeSetElemAttr("netbox_site.test", "tags_all.*", ignoredTag),
+					resource.TestCheckNoResourceAttr("netbox_site.test", "custom_fields."+ignoredField),
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields_all."+ignoredField, "2026-10-17"),
+				),
+			},
+		},
+	})
+}
@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxAggregate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxAggregateCreate,
-		Read:   resourceNetboxAggregateRead,
-		Update: resourceNetboxAggregateUpdate,
-		Delete: resourceNetboxAggregateDelete,
+		CreateContext: resourceNetboxAggregateCreate,
+		ReadContext:   resourceNetboxAggregateRead,
+		UpdateContext: resourceNetboxAggregateUpdate,
+		DeleteContext: resourceNetboxAggregateDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/features/ipam/#aggregates):
 
@@ -38,14 +40,16 @@ func resourceNetboxAggregate() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
-func resourceNetboxAggregateCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAggregateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	data := models.WritableAggregate{}
 
@@ -64,25 +68,26 @@ func resourceNetboxAggregateCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	params := ipam.NewIpamAggregatesCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamAggregatesCreateParams().WithContext(ctx).WithData(&data)
 	res, err := api.Ipam.IpamAggregatesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxAggregateRead(d, m)
+	return resourceNetboxAggregateRead(ctx, d, m)
 }
 
-func resourceNetboxAggregateRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAggregateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamAggregatesReadParams().WithID(id)
+	params := ipam.NewIpamAggregatesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Ipam.IpamAggregatesRead(params, nil)
 	if err != nil {
@@ -94,7 +99,7 @@ func resourceNetboxAggregateRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("description", res.GetPayload().Description)
@@ -115,11 +120,12 @@ func resourceNetboxAggregateRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	api.readTags(d, res.GetPayload().Tags)
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 
 	return nil
 }
 
-func resourceNetboxAggregateUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAggregateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableAggregate{}
@@ -138,23 +144,24 @@ func resourceNetboxAggregateUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	params := ipam.NewIpamAggregatesUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamAggregatesUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err = api.Ipam.IpamAggregatesUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
-	return resourceNetboxAggregateRead(d, m)
+	return resourceNetboxAggregateRead(ctx, d, m)
 }
 
-func resourceNetboxAggregateDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAggregateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamAggregatesDeleteParams().WithID(id)
+	params := ipam.NewIpamAggregatesDeleteParams().WithContext(ctx).WithID(id)
 	_, err := api.Ipam.IpamAggregatesDelete(params, nil)
 	if err != nil {
 		if errresp, ok := err.(*ipam.IpamAggregatesDeleteDefault); ok {
@@ -163,7 +170,7 @@ func resourceNetboxAggregateDelete(d *schema.ResourceData, m interface{}) error
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId("")
 	return nil
@@ -1,19 +1,21 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxAsn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxAsnCreate,
-		Read:   resourceNetboxAsnRead,
-		Update: resourceNetboxAsnUpdate,
-		Delete: resourceNetboxAsnDelete,
+		CreateContext: resourceNetboxAsnCreate,
+		ReadContext:   resourceNetboxAsnRead,
+		UpdateContext: resourceNetboxAsnUpdate,
+		DeleteContext: resourceNetboxAsnDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/features/ipam/#asn):
 > ASN is short for Autonomous System Number. This identifier is used in the BGP protocol to identify which "autonomous system" a particular prefix is originating and transiting through.
@@ -41,7 +43,9 @@ func resourceNetboxAsn() *schema.Resource {
 				Optional:    true,
 				Description: "Comments field for the AS Number record",
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -49,7 +53,7 @@ func resourceNetboxAsn() *schema.Resource {
 	}
 }
 
-func resourceNetboxAsnCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAsnCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableASN{}
@@ -63,27 +67,28 @@ func resourceNetboxAsnCreate(d *schema.ResourceData, m interface{}) error {
 	data.Description = d.Get("description").(string)
 	data.Comments = d.Get("comments").(string)
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	params := ipam.NewIpamAsnsCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamAsnsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Ipam.IpamAsnsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxAsnRead(d, m)
+	return resourceNetboxAsnRead(ctx, d, m)
 }
 
-func resourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAsnRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamAsnsReadParams().WithID(id)
+	params := ipam.NewIpamAsnsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Ipam.IpamAsnsRead(params, nil)
 
@@ -96,7 +101,7 @@ func resourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	asn := res.GetPayload()
@@ -105,11 +110,12 @@ func resourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", asn.Description)
 	d.Set("comments", asn.Comments)
 	api.readTags(d, asn.Tags)
+	api.readCustomFields(d, asn.CustomFields)
 
 	return nil
 }
 
-func resourceNetboxAsnUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAsnUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -124,26 +130,27 @@ func resourceNetboxAsnUpdate(d *schema.ResourceData, m interface{}) error {
 	data.Description = d.Get("description").(string)
 	data.Comments = d.Get("comments").(string)
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	params := ipam.NewIpamAsnsUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamAsnsUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Ipam.IpamAsnsUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxAsnRead(d, m)
+	return resourceNetboxAsnRead(ctx, d, m)
 }
 
-func resourceNetboxAsnDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAsnDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamAsnsDeleteParams().WithID(id)
+	params := ipam.NewIpamAsnsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Ipam.IpamAsnsDelete(params, nil)
 	if err != nil {
@@ -153,7 +160,7 @@ func resourceNetboxAsnDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -0,0 +1,212 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The generated client does not cover ASN ranges, so they are managed through
+// the REST API directly.
+const asnRangesPath = "/ipam/asn-ranges/"
+
+// netboxASNRange is an ASN range as returned by the Netbox API.
+type netboxASNRange struct {
+	ID           int64                `json:"id"`
+	Name         string               `json:"name"`
+	Slug         string               `json:"slug"`
+	Rir          *models.NestedRIR    `json:"rir"`
+	Start        int64                `json:"start"`
+	End          int64                `json:"end"`
+	Tenant       *models.NestedTenant `json:"tenant"`
+	Description  string               `json:"description"`
+	Tags         []*models.NestedTag  `json:"tags"`
+	CustomFields interface{}          `json:"custom_fields"`
+	AsnCount     int64                `json:"asn_count"`
+}
+
+func asnRangePath(id int64) string {
+	return fmt.Sprintf("%s%d/", asnRangesPath, id)
+}
+
+func resourceNetboxAsnRange() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxAsnRangeCreate,
+		ReadContext:   resourceNetboxAsnRangeRead,
+		UpdateContext: resourceNetboxAsnRangeUpdate,
+		DeleteContext: resourceNetboxAsnRangeDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/asnrange/):
+
+> Ranges can be defined to group AS numbers numerically and to facilitate their automatic provisioning. Each range must be assigned to a RIR.
+
+Use ` + "`netbox_available_asn`" + ` to allocate ASNs from a range.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"start": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4294967295),
+			},
+			"end": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 4294967295),
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"asn_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of ASNs allocated from the range.",
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxAsnRangeData(ctx context.Context, api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	slug := getSlug(name)
+	if slugValue, ok := d.GetOk("slug"); ok {
+		slug = slugValue.(string)
+	}
+
+	data := map[string]interface{}{
+		"name":        name,
+		"slug":        slug,
+		"rir":         d.Get("rir_id").(int),
+		"start":       d.Get("start").(int),
+		"end":         d.Get("end").(int),
+		"tenant":      getOptionalInt(d, "tenant_id"),
+		"description": d.Get("description").(string),
+		"tags":        tags,
+	}
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+	return data, nil
+}
+
+func resourceNetboxAsnRangeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxAsnRangeData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var asnRange netboxASNRange
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, asnRangesPath, nil, data, &asnRange); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(asnRange.ID, 10))
+
+	return resourceNetboxAsnRangeRead(ctx, d, m)
+}
+
+func resourceNetboxAsnRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var asnRange netboxASNRange
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, asnRangePath(id), nil, nil, &asnRange); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", asnRange.Name)
+	d.Set("slug", asnRange.Slug)
+	d.Set("start", asnRange.Start)
+	d.Set("end", asnRange.End)
+	d.Set("description", asnRange.Description)
+	d.Set("asn_count", asnRange.AsnCount)
+
+	if asnRange.Rir != nil {
+		d.Set("rir_id", asnRange.Rir.ID)
+	} else {
+		d.Set("rir_id", nil)
+	}
+
+	if asnRange.Tenant != nil {
+		d.Set("tenant_id", asnRange.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	api.readTags(d, asnRange.Tags)
+	api.readCustomFields(d, asnRange.CustomFields)
+
+	return nil
+}
+
+func resourceNetboxAsnRangeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxAsnRangeData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPut, asnRangePath(id), nil, data, nil); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxAsnRangeRead(ctx, d, m)
+}
+
+func resourceNetboxAsnRangeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodDelete, asnRangePath(id), nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
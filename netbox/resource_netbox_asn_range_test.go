@@ -0,0 +1,95 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAsnRange_basic(t *testing.T) {
+	testSlug := "asn_range_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]sa"
+}
+
+resource "netbox_rir" "test" {
+  name       = "%[1]s"
+  is_private = true
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_asn_range" "test" {
+  name        = "%[1]s"
+  rir_id      = netbox_rir.test.id
+  start       = 4200001000
+  end         = 4200001009
+  tenant_id   = netbox_tenant.test.id
+  description = "test"
+
+  tags = ["%[1]sa"]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "start", "4200001000"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "end", "4200001009"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "description", "test"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "asn_count", "0"),
+					resource.TestCheckResourceAttrPair("netbox_asn_range.test", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_asn_range.test", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "tags.0", testName+"a"),
+				),
+			},
+			{
+				ResourceName:      "netbox_asn_range.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_asn_range", &resource.Sweeper{
+		Name:         "netbox_asn_range",
+		Dependencies: []string{"netbox_asn"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*providerState)
+			var res struct {
+				Results []*netboxASNRange `json:"results"`
+			}
+			if err := netboxAPIRequest(context.Background(), api.Transport, http.MethodGet, asnRangesPath, nil, nil, &res); err != nil {
+				return err
+			}
+			for _, asnRange := range res.Results {
+				if strings.HasPrefix(asnRange.Name, testPrefix) {
+					if err := netboxAPIRequest(context.Background(), api.Transport, http.MethodDelete, asnRangePath(asnRange.ID), nil, nil, nil); err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted an asn range")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -3,6 +3,7 @@ package netbox
 import (
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
@@ -52,39 +53,43 @@ resource "netbox_asn" "test" {
 	})
 }
 
-//func TestAccNetboxAsn_customFields(t *testing.T) {
-//	testSlug := "asn_detail"
-//	testName := testAccGetTestName(testSlug)
-//	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
-//	resource.Test(t, resource.TestCase{
-//		PreCheck:  func() { testAccPreCheck(t) },
-//		Providers: testAccProviders,
-//		Steps: []resource.TestStep{
-//			{
-//				Config: fmt.Sprintf(`
-//resource "netbox_custom_field" "test" {
-//	name          = "%[1]s"
-//	type          = "text"
-//	content_types = ["ipam.asn"]
-//}
-//resource "netbox_asn" "test" {
-//  name          = "%[2]s"
-//  status        = "active"
-//  latitude      = "12.123456"
-//  longitude     = "-13.123456"
-//  timezone      = "Africa/Johannesburg"
-//  custom_fields = {"${netbox_custom_field.test.name}" = "81"}
-//}`, testField, testName),
-//				Check: resource.ComposeTestCheckFunc(
-//					resource.TestCheckResourceAttr("netbox_asn.test", "custom_fields."+testField, "81"),
-//					resource.TestCheckResourceAttr("netbox_asn.test", "timezone", "Africa/Johannesburg"),
-//					resource.TestCheckResourceAttr("netbox_asn.test", "latitude", "12.123456"),
-//					resource.TestCheckResourceAttr("netbox_asn.test", "longitude", "-13.123456"),
-//				),
-//			},
-//		},
-//	})
-//}
+func TestAccNetboxAsn_customFields(t *testing.T) {
+	testSlug := "asn_detail"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["ipam.asn"]
+}
+
+resource "netbox_rir" "test" {
+  name = "%[2]s"
+}
+
+resource "netbox_asn" "test" {
+  asn           = 1338
+  rir_id        = netbox_rir.test.id
+  custom_fields = {"${netbox_custom_field.test.name}" = "81"}
+}`, testField, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_asn.test", "custom_fields."+testField, "81"),
+				),
+			},
+			{
+				ResourceName:      "netbox_asn.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
 
 func init() {
 	resource.AddTestSweepers("netbox_asn", &resource.Sweeper{
@@ -0,0 +1,213 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxAvailableAsn() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxAvailableAsnCreate,
+		ReadContext:   resourceNetboxAvailableAsnRead,
+		UpdateContext: resourceNetboxAvailableAsnUpdate,
+		DeleteContext: resourceNetboxAvailableAsnDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):This resource will allocate the next available AS number from a given ASN range (specified by ID). The allocated ASN is assigned to the RIR of the range.
+
+An imported ASN is assigned to the ASN range of its RIR that contains it, if there is exactly one.`,
+
+		Schema: map[string]*schema.Schema{
+			"asn_range_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"asn": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxAvailableAsnCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	rangeID := int64(d.Get("asn_range_id").(int))
+
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the generated client does not know the available-asns endpoint
+	data := map[string]interface{}{
+		"tenant":      getOptionalInt(d, "tenant_id"),
+		"description": d.Get("description").(string),
+		"comments":    d.Get("comments").(string),
+		"tags":        tags,
+	}
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+
+	var asn models.ASN
+	path := fmt.Sprintf("%savailable-asns/", asnRangePath(rangeID))
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, path, nil, data, &asn); err != nil {
+		return availableObjectsDiag(err, d, fmt.Sprintf("ASN range %d", rangeID))
+	}
+
+	d.SetId(strconv.FormatInt(asn.ID, 10))
+
+	return resourceNetboxAvailableAsnRead(ctx, d, m)
+}
+
+func resourceNetboxAvailableAsnRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamAsnsReadParams().WithContext(ctx).WithID(id)
+
+	res, err := api.Ipam.IpamAsnsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamAsnsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	asn := res.GetPayload()
+	d.Set("asn", asn.Asn)
+	d.Set("rir_id", asn.Rir.ID)
+	d.Set("description", asn.Description)
+	d.Set("comments", asn.Comments)
+
+	if _, ok := d.GetOk("asn_range_id"); !ok {
+		// Netbox does not store the range an ASN was allocated from, so it is
+		// looked up after an import
+		rangeID, err := findAsnRangeOfAsn(ctx, api, asn.Rir.ID, *asn.Asn)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if rangeID != 0 {
+			d.Set("asn_range_id", rangeID)
+		}
+	}
+
+	if asn.Tenant != nil {
+		d.Set("tenant_id", asn.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	api.readTags(d, asn.Tags)
+	api.readCustomFields(d, asn.CustomFields)
+
+	return nil
+}
+
+// findAsnRangeOfAsn returns the ID of the ASN range of the given RIR that
+// contains the given ASN, or 0 if there is none or more than one.
+func findAsnRangeOfAsn(ctx context.Context, api *providerState, rirID, asn int64) (int64, error) {
+	query := url.Values{
+		"rir_id":     {strconv.FormatInt(rirID, 10)},
+		"start__lte": {strconv.FormatInt(asn, 10)},
+		"end__gte":   {strconv.FormatInt(asn, 10)},
+	}
+
+	var res struct {
+		Results []*netboxASNRange `json:"results"`
+	}
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, asnRangesPath, query, nil, &res); err != nil {
+		return 0, err
+	}
+	if len(res.Results) != 1 {
+		return 0, nil
+	}
+	return res.Results[0].ID, nil
+}
+
+func resourceNetboxAvailableAsnUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data := models.WritableASN{}
+
+	// the ASN and the RIR were assigned by Netbox and are kept
+	asn := int64(d.Get("asn").(int))
+	data.Asn = &asn
+
+	rir := int64(d.Get("rir_id").(int))
+	data.Rir = &rir
+
+	data.Tenant = getOptionalInt(d, "tenant_id")
+	data.Description = d.Get("description").(string)
+	data.Comments = d.Get("comments").(string)
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamAsnsUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
+
+	_, err = api.Ipam.IpamAsnsUpdate(params, nil)
+	if err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxAvailableAsnRead(ctx, d, m)
+}
+
+func resourceNetboxAvailableAsnDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamAsnsDeleteParams().WithContext(ctx).WithID(id)
+
+	_, err := api.Ipam.IpamAsnsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamAsnsDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAccNetboxAvailableAsnConfig(testName string, count int) string {
+	return fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name       = "%[1]s"
+  is_private = true
+}
+
+resource "netbox_asn_range" "test" {
+  name   = "%[1]s"
+  rir_id = netbox_rir.test.id
+  start  = 4200002000
+  end    = 4200002001
+}
+
+resource "netbox_available_asn" "test" {
+  count        = %[2]d
+  asn_range_id = netbox_asn_range.test.id
+  description  = "rack ${count.index}"
+}`, testName, count)
+}
+
+func TestAccNetboxAvailableAsn_basic(t *testing.T) {
+	testSlug := "available_asn_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxAvailableAsnConfig(testName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_asn.test.0", "asn", "4200002000"),
+					resource.TestCheckResourceAttr("netbox_available_asn.test.0", "description", "rack 0"),
+					resource.TestCheckResourceAttrPair("netbox_available_asn.test.0", "rir_id", "netbox_rir.test", "id"),
+				),
+			},
+			{
+				Config: testAccNetboxAvailableAsnConfig(testName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_asn.test.0", "asn", "4200002000"),
+					resource.TestCheckResourceAttr("netbox_available_asn.test.1", "asn", "4200002001"),
+				),
+			},
+			{
+				ResourceName:      "netbox_available_asn.test.1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccNetboxAvailableAsnConfig(testName, 3),
+				ExpectError: regexp.MustCompile(`ASN range \d+ is exhausted`),
+			},
+		},
+	})
+}
+
+func TestResourceNetboxAvailableAsnCreate(t *testing.T) {
+	var request map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/ipam/asn-ranges/3/available-asns/":
+			json.NewDecoder(r.Body).Decode(&request)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 21, "asn": 4200000000})
+		case "POST /api/ipam/asn-ranges/4/available-asns/":
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(map[string]string{"detail": "Request exceeds the number of available ASNs (0)"})
+		case "GET /api/ipam/asns/21/":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":          21,
+				"asn":         4200000000,
+				"rir":         map[string]interface{}{"id": 5, "name": "private", "slug": "private"},
+				"description": "rack 1",
+			})
+		case "GET /api/ipam/asn-ranges/":
+			assert.Equal(t, "5", r.URL.Query().Get("rir_id"))
+			assert.Equal(t, "4200000000", r.URL.Query().Get("start__lte"))
+			assert.Equal(t, "4200000000", r.URL.Query().Get("end__gte"))
+			json.NewEncoder(w).Encode(map[string]interface{}{"count": 1, "results": []map[string]interface{}{{"id": 3}}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	netboxClient, err := config.Client()
+	require.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient, defaultTags: schema.NewSet(schema.HashString, nil)}
+
+	res := Provider().ResourcesMap["netbox_available_asn"]
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"asn_range_id": 3,
+		"description":  "rack 1",
+	})
+
+	diags := resourceNetboxAvailableAsnCreate(context.Background(), d, api)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "rack 1", request["description"])
+	assert.Equal(t, "21", d.Id())
+	assert.Equal(t, 4200000000, d.Get("asn"))
+	assert.Equal(t, 5, d.Get("rir_id"))
+
+	d = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"asn_range_id": 4,
+	})
+	diags = resourceNetboxAvailableAsnCreate(context.Background(), d, api)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "ASN range 4 is exhausted", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "available ASNs")
+	assert.Equal(t, "", d.Id())
+
+	// the range of an imported ASN is looked up
+	d = res.Data(nil)
+	d.SetId("21")
+	diags = resourceNetboxAvailableAsnRead(context.Background(), d, api)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 3, d.Get("asn_range_id"))
+}
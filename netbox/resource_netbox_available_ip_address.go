@@ -1,20 +1,26 @@
 package netbox
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxAvailableIPAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxAvailableIPAddressCreate,
-		Read:   resourceNetboxAvailableIPAddressRead,
-		Update: resourceNetboxAvailableIPAddressUpdate,
-		Delete: resourceNetboxAvailableIPAddressDelete,
+		CreateContext: resourceNetboxAvailableIPAddressCreate,
+		ReadContext:   resourceNetboxAvailableIPAddressRead,
+		UpdateContext: resourceNetboxAvailableIPAddressUpdate,
+		DeleteContext: resourceNetboxAvailableIPAddressDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):Per [the docs](https://netbox.readthedocs.io/en/stable/models/ipam/ipaddress/):
 
@@ -90,7 +96,9 @@ This resource will retrieve the next available IP address from a given prefix or
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 			"role": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -104,7 +112,7 @@ This resource will retrieve the next available IP address from a given prefix or
 	}
 }
 
-func resourceNetboxAvailableIPAddressCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAvailableIPAddressCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	prefixID := int64(d.Get("prefix_id").(int))
 	vrfID := int64(int64(d.Get("vrf_id").(int)))
@@ -115,27 +123,41 @@ func resourceNetboxAvailableIPAddressCreate(d *schema.ResourceData, m interface{
 	data := models.AvailableIP{
 		Vrf: &nestedvrf,
 	}
+	var ipAddresses []*models.IPAddress
+	var parent string
 	if prefixID != 0 {
-		params := ipam.NewIpamPrefixesAvailableIpsCreateParams().WithID(prefixID).WithData([]*models.AvailableIP{&data})
-		res, _ := api.Ipam.IpamPrefixesAvailableIpsCreate(params, nil)
-		// Since we generated the ip_address, set that now
-		d.SetId(strconv.FormatInt(res.Payload[0].ID, 10))
-		d.Set("ip_address", *res.Payload[0].Address)
+		parent = fmt.Sprintf("prefix %d", prefixID)
+		params := ipam.NewIpamPrefixesAvailableIpsCreateParams().WithContext(ctx).WithID(prefixID).WithData([]*models.AvailableIP{&data})
+		res, err := api.Ipam.IpamPrefixesAvailableIpsCreate(params, nil)
+		if err != nil {
+			return availableObjectsDiag(err, d, parent)
+		}
+		ipAddresses = res.GetPayload()
 	}
 	if rangeID != 0 {
-		params := ipam.NewIpamIPRangesAvailableIpsCreateParams().WithID(rangeID).WithData([]*models.AvailableIP{&data})
-		res, _ := api.Ipam.IpamIPRangesAvailableIpsCreate(params, nil)
-		// Since we generated the ip_address, set that now
-		d.SetId(strconv.FormatInt(res.Payload[0].ID, 10))
-		d.Set("ip_address", *res.Payload[0].Address)
+		parent = fmt.Sprintf("IP range %d", rangeID)
+		params := ipam.NewIpamIPRangesAvailableIpsCreateParams().WithContext(ctx).WithID(rangeID).WithData([]*models.AvailableIP{&data})
+		res, err := api.Ipam.IpamIPRangesAvailableIpsCreate(params, nil)
+		if err != nil {
+			return availableObjectsDiag(err, d, parent)
+		}
+		ipAddresses = res.GetPayload()
 	}
-	return resourceNetboxAvailableIPAddressUpdate(d, m)
+	if len(ipAddresses) == 0 {
+		return diag.Errorf("%s is exhausted, Netbox returned no available IP address", parent)
+	}
+
+	// Since we generated the ip_address, set that now
+	d.SetId(strconv.FormatInt(ipAddresses[0].ID, 10))
+	d.Set("ip_address", ipAddresses[0].Address)
+
+	return resourceNetboxAvailableIPAddressUpdate(ctx, d, m)
 }
 
-func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAvailableIPAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamIPAddressesReadParams().WithID(id)
+	params := ipam.NewIpamIPAddressesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Ipam.IpamIPAddressesRead(params, nil)
 	if err != nil {
@@ -147,7 +169,7 @@ func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{})
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	ipAddress := res.GetPayload()
@@ -191,10 +213,11 @@ func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{})
 	d.Set("description", ipAddress.Description)
 	d.Set("status", ipAddress.Status.Value)
 	api.readTags(d, ipAddress.Tags)
+	api.readCustomFields(d, ipAddress.CustomFields)
 	return nil
 }
 
-func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAvailableIPAddressUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -237,25 +260,26 @@ func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	params := ipam.NewIpamIPAddressesUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamIPAddressesUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Ipam.IpamIPAddressesUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
-	return resourceNetboxAvailableIPAddressRead(d, m)
+	return resourceNetboxAvailableIPAddressRead(ctx, d, m)
 }
 
-func resourceNetboxAvailableIPAddressDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAvailableIPAddressDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamIPAddressesDeleteParams().WithID(id)
+	params := ipam.NewIpamIPAddressesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Ipam.IpamIPAddressesDelete(params, nil)
 	if err != nil {
@@ -265,7 +289,23 @@ func resourceNetboxAvailableIPAddressDelete(d *schema.ResourceData, m interface{
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
+
+// availableObjectsDiag turns an error of an allocation of available objects,
+// e.g. IP addresses or ASNs, into diagnostics. Netbox answers with a conflict
+// if the parent does not have enough free objects left.
+func availableObjectsDiag(err error, d *schema.ResourceData, parent string) diag.Diagnostics {
+	var apiErr netboxAPIError
+	if errors.As(err, &apiErr) && apiErr.Code() == http.StatusConflict {
+		detail := withRequestID(strings.Join(flattenNetboxErrors(apiErr.GetPayload())[""], "\n"), apiErr)
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s is exhausted", parent),
+			Detail:   detail,
+		}}
+	}
+	return diagFromNetboxError(err, d)
+}
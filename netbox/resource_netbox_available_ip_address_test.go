@@ -284,6 +284,27 @@ resource "netbox_available_ip_address" "test" {
 	})
 }
 
+func TestAccNetboxAvailableIPAddress_exhausted(t *testing.T) {
+	testPrefix := "1.1.9.0/31"
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_prefix" "test" {
+  prefix = "%s"
+  status = "active"
+}
+resource "netbox_available_ip_address" "test" {
+  count     = 3
+  prefix_id = netbox_prefix.test.id
+}`, testPrefix),
+				ExpectError: regexp.MustCompile("prefix [0-9]+ is exhausted"),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_available_ip_address", &resource.Sweeper{
 		Name:         "netbox_available_ip_address",
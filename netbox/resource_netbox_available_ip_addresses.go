@@ -0,0 +1,444 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	log "github.com/sirupsen/logrus"
+)
+
+// The generated client neither sends the attributes of the requested
+// addresses to the available-ips endpoints nor supports bulk operations, so
+// this resource uses the REST API directly.
+const ipAddressesPath = "/ipam/ip-addresses/"
+
+func resourceNetboxAvailableIPAddresses() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxAvailableIPAddressesCreate,
+		ReadContext:   resourceNetboxAvailableIPAddressesRead,
+		UpdateContext: resourceNetboxAvailableIPAddressesUpdate,
+		DeleteContext: resourceNetboxAvailableIPAddressesDelete,
+		CustomizeDiff: resourceNetboxAvailableIPAddressesCustomizeDiff,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):This resource allocates multiple available IP addresses from a given prefix or IP range (specified by ID) in a single request. Either all addresses are allocated or none, e.g. for the nodes of a Kubernetes node pool.
+
+Each ` + "`address`" + ` block allocates one IP address. Adding blocks at the end allocates further addresses, removing blocks from the end releases them. The ID of the resource is the ID of the first address. Addresses deleted outside of Terraform are allocated again on the next apply.
+
+The resource is imported with the ID ` + "`prefix_id <prefix ID> <address IDs>`" + ` or ` + "`ip_range_id <IP range ID> <address IDs>`" + `, where the address IDs are separated by commas, e.g. ` + "`prefix_id 7 11,12,13`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"prefix_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
+			},
+			"ip_range_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
+			},
+			"address": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The allocated IP addresses, in the order of the `address` blocks.",
+			},
+			"vrf_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxIPAddressStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxIPAddressStatusOptions),
+				Default:      "active",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxIPAddressRoleOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxIPAddressRoleOptions),
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetboxAvailableIPAddressesImport,
+		},
+	}
+}
+
+func resourceNetboxAvailableIPAddressesImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parentKey, parentID, ids, err := resourceNetboxAvailableIPAddressesParseImport(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, map[string]interface{}{"id": id})
+	}
+	d.Set(parentKey, parentID)
+	d.Set("address", items)
+	d.SetId(strconv.Itoa(ids[0]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceNetboxAvailableIPAddressesParseImport(importStr string) (string, int, []int, error) {
+	parts := strings.SplitN(importStr, " ", 3)
+
+	if len(parts) != 3 || (parts[0] != "prefix_id" && parts[0] != "ip_range_id") || parts[2] == "" {
+		return "", 0, nil, fmt.Errorf("unexpected format of (%s), expected 'prefix_id|ip_range_id parent_id address_ids'", importStr)
+	}
+
+	parentID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, nil, fmt.Errorf("parent_id (%s) is not an integer", parts[1])
+	}
+
+	var ids []int
+	for _, part := range strings.Split(parts[2], ",") {
+		id, err := strconv.Atoi(part)
+		if err != nil {
+			return "", 0, nil, fmt.Errorf("address ID (%s) is not an integer", part)
+		}
+		ids = append(ids, id)
+	}
+
+	return parts[0], parentID, ids, nil
+}
+
+// resourceNetboxAvailableIPAddressesCustomizeDiff marks the allocated IP
+// addresses as changing when address blocks are added or removed, or when an
+// address was deleted outside of Terraform and is allocated again.
+func resourceNetboxAvailableIPAddressesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	o, n := d.GetChange("address")
+	oldItems := o.([]interface{})
+	if len(oldItems) != len(n.([]interface{})) {
+		return d.SetNewComputed("ip_addresses")
+	}
+	for _, item := range oldItems {
+		if item.(map[string]interface{})["id"].(int) == 0 {
+			return d.SetNewComputed("ip_addresses")
+		}
+	}
+	return nil
+}
+
+// availableIPAddressesParent returns a description of the prefix or IP range
+// to allocate from and the path of its available-ips endpoint.
+func availableIPAddressesParent(d *schema.ResourceData) (string, string) {
+	if prefixID, ok := d.GetOk("prefix_id"); ok {
+		return fmt.Sprintf("prefix %d", prefixID), fmt.Sprintf("/ipam/prefixes/%d/available-ips/", prefixID)
+	}
+	rangeID := d.Get("ip_range_id").(int)
+	return fmt.Sprintf("IP range %d", rangeID), fmt.Sprintf("/ipam/ip-ranges/%d/available-ips/", rangeID)
+}
+
+// availableIPAddressesData returns the request bodies for the given address
+// blocks, with the attributes shared by all addresses.
+func availableIPAddressesData(ctx context.Context, api *providerState, d *schema.ResourceData, items []interface{}) ([]map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+	customFields := api.customFieldsFromResourceData(d)
+
+	data := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		address := item.(map[string]interface{})
+		ipData := map[string]interface{}{
+			"status":      d.Get("status").(string),
+			"role":        d.Get("role").(string),
+			"vrf":         getOptionalInt(d, "vrf_id"),
+			"tenant":      getOptionalInt(d, "tenant_id"),
+			"tags":        tags,
+			"dns_name":    address["dns_name"].(string),
+			"description": address["description"].(string),
+		}
+		if customFields != nil {
+			ipData["custom_fields"] = customFields
+		}
+		if id, ok := address["id"].(int); ok && id != 0 {
+			ipData["id"] = id
+		}
+		data = append(data, ipData)
+	}
+	return data, nil
+}
+
+// allocateAvailableIPAddresses allocates one address per given address block
+// and stores the ID and IP address of each in the block.
+func allocateAvailableIPAddresses(ctx context.Context, api *providerState, d *schema.ResourceData, items []interface{}) diag.Diagnostics {
+	parent, path := availableIPAddressesParent(d)
+
+	data, err := availableIPAddressesData(ctx, api, d, items)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ipAddresses []*models.IPAddress
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, path, nil, data, &ipAddresses); err != nil {
+		return availableObjectsDiag(err, d, parent)
+	}
+	if len(ipAddresses) != len(items) {
+		return diag.Errorf("%s is exhausted, Netbox returned %d of %d requested IP addresses", parent, len(ipAddresses), len(items))
+	}
+
+	for i, ipAddress := range ipAddresses {
+		address := items[i].(map[string]interface{})
+		address["id"] = int(ipAddress.ID)
+		address["ip_address"] = *ipAddress.Address
+	}
+	return nil
+}
+
+func resourceNetboxAvailableIPAddressesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	items := d.Get("address").([]interface{})
+	if diags := allocateAvailableIPAddresses(ctx, api, d, items); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(items[0].(map[string]interface{})["id"].(int)))
+	d.Set("address", items)
+
+	return resourceNetboxAvailableIPAddressesRead(ctx, d, m)
+}
+
+func resourceNetboxAvailableIPAddressesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	items := d.Get("address").([]interface{})
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		if id := item.(map[string]interface{})["id"].(int); id != 0 {
+			ids = append(ids, strconv.Itoa(id))
+		}
+	}
+
+	var results []*models.IPAddress
+	if len(ids) > 0 {
+		var err error
+		results, err = fetchAllPages(0, api.pageConcurrency, func(limit, offset int64) ([]*models.IPAddress, int64, error) {
+			query := url.Values{
+				"id":     ids,
+				"limit":  {strconv.FormatInt(limit, 10)},
+				"offset": {strconv.FormatInt(offset, 10)},
+			}
+
+			var res struct {
+				Count   int64               `json:"count"`
+				Results []*models.IPAddress `json:"results"`
+			}
+			if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, ipAddressesPath, query, nil, &res); err != nil {
+				return nil, 0, err
+			}
+			return res.Results, res.Count, nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if len(results) == 0 {
+		// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+		d.SetId("")
+		return nil
+	}
+
+	ipAddresses := make(map[int]*models.IPAddress, len(results))
+	for _, ipAddress := range results {
+		ipAddresses[int(ipAddress.ID)] = ipAddress
+	}
+
+	var first *models.IPAddress
+	allocated := make([]string, 0, len(items))
+	for _, item := range items {
+		address := item.(map[string]interface{})
+		ipAddress, ok := ipAddresses[address["id"].(int)]
+		if !ok {
+			// the address is allocated again on the next apply, the
+			// other addresses are kept
+			if address["id"].(int) != 0 {
+				log.WithFields(log.Fields{
+					"id": address["id"],
+				}).Warn("Available IP address was deleted outside of Terraform, it will be allocated again")
+			}
+			address["id"] = 0
+			address["ip_address"] = ""
+			allocated = append(allocated, "")
+			continue
+		}
+		if first == nil {
+			first = ipAddress
+		}
+
+		address["ip_address"] = *ipAddress.Address
+		address["dns_name"] = ipAddress.DNSName
+		address["description"] = ipAddress.Description
+		allocated = append(allocated, *ipAddress.Address)
+	}
+	d.Set("address", items)
+	d.Set("ip_addresses", allocated)
+
+	// the shared attributes are read from the first existing address
+	ipAddress := first
+
+	if ipAddress.Vrf != nil {
+		d.Set("vrf_id", ipAddress.Vrf.ID)
+	} else {
+		d.Set("vrf_id", nil)
+	}
+
+	if ipAddress.Tenant != nil {
+		d.Set("tenant_id", ipAddress.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	if ipAddress.Role != nil {
+		d.Set("role", ipAddress.Role.Value)
+	} else {
+		d.Set("role", nil)
+	}
+
+	d.Set("status", ipAddress.Status.Value)
+	api.readTags(d, ipAddress.Tags)
+	api.readCustomFields(d, ipAddress.CustomFields)
+	return nil
+}
+
+func resourceNetboxAvailableIPAddressesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	o, n := d.GetChange("address")
+	oldItems := o.([]interface{})
+	newItems := n.([]interface{})
+
+	// release the addresses removed from the end first, so they can be
+	// allocated again right away
+	if len(newItems) < len(oldItems) {
+		if err := releaseAvailableIPAddresses(ctx, api, oldItems[len(newItems):]); err != nil {
+			return diagFromNetboxError(err, d)
+		}
+	}
+
+	sharedChanged := d.HasChanges("vrf_id", "tenant_id", "status", "role", tagsAllKey, customFieldsKey, customFieldsJSONKey)
+
+	var changed, allocate []interface{}
+	for i := 0; i < len(oldItems) && i < len(newItems); i++ {
+		oldAddress := oldItems[i].(map[string]interface{})
+		newAddress := newItems[i].(map[string]interface{})
+		newAddress["id"] = oldAddress["id"]
+		newAddress["ip_address"] = oldAddress["ip_address"]
+
+		if newAddress["id"].(int) == 0 {
+			// deleted outside of Terraform
+			allocate = append(allocate, newAddress)
+		} else if sharedChanged || newAddress["dns_name"] != oldAddress["dns_name"] || newAddress["description"] != oldAddress["description"] {
+			changed = append(changed, newAddress)
+		}
+	}
+	if len(changed) > 0 {
+		data, err := availableIPAddressesData(ctx, api, d, changed)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := netboxAPIRequest(ctx, api.Transport, http.MethodPatch, ipAddressesPath, nil, data, nil); err != nil {
+			return diagFromNetboxError(err, d)
+		}
+	}
+
+	if len(newItems) > len(oldItems) {
+		allocate = append(allocate, newItems[len(oldItems):]...)
+	}
+	if len(allocate) > 0 {
+		if diags := allocateAvailableIPAddresses(ctx, api, d, allocate); diags.HasError() {
+			// only keep the addresses that exist in the state
+			d.Set("address", newItems[:min(len(oldItems), len(newItems))])
+			return diags
+		}
+	}
+	d.SetId(strconv.Itoa(newItems[0].(map[string]interface{})["id"].(int)))
+	d.Set("address", newItems)
+
+	return resourceNetboxAvailableIPAddressesRead(ctx, d, m)
+}
+
+// releaseAvailableIPAddresses deletes the addresses of the given address
+// blocks, skipping the ones that were already deleted outside of Terraform.
+func releaseAvailableIPAddresses(ctx context.Context, api *providerState, items []interface{}) error {
+	data := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if id := item.(map[string]interface{})["id"].(int); id != 0 {
+			data = append(data, map[string]interface{}{"id": id})
+		}
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return netboxAPIRequest(ctx, api.Transport, http.MethodDelete, ipAddressesPath, nil, data, nil)
+}
+
+func resourceNetboxAvailableIPAddressesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	if err := releaseAvailableIPAddresses(ctx, api, d.Get("address").([]interface{})); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
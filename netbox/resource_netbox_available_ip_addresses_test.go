@@ -0,0 +1,243 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAccNetboxAvailableIPAddressesConfig(prefix string, dnsNames ...string) string {
+	config := fmt.Sprintf(`
+resource "netbox_prefix" "test" {
+  prefix = "%s"
+  status = "active"
+}
+resource "netbox_available_ip_addresses" "test" {
+  prefix_id = netbox_prefix.test.id
+  status    = "reserved"
+`, prefix)
+	for _, dnsName := range dnsNames {
+		config += fmt.Sprintf(`
+  address {
+    dns_name    = "%s"
+    description = "node %s"
+  }
+`, dnsName, dnsName)
+	}
+	return config + "}"
+}
+
+func TestAccNetboxAvailableIPAddresses_basic(t *testing.T) {
+	testPrefix := "1.1.8.0/29"
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxAvailableIPAddressesConfig(testPrefix, "node-0.example.com", "node-1.example.com", "node-2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.#", "3"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.0.ip_address", "1.1.8.1/29"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.0.dns_name", "node-0.example.com"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.2.ip_address", "1.1.8.3/29"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.2.description", "node node-2.example.com"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "status", "reserved"),
+				),
+			},
+			{
+				Config: testAccNetboxAvailableIPAddressesConfig(testPrefix, "node-0.example.com", "node-1.example.com", "node-2.example.com", "node-3.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.#", "4"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.0.ip_address", "1.1.8.1/29"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.3.ip_address", "1.1.8.4/29"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.3.dns_name", "node-3.example.com"),
+				),
+			},
+			{
+				Config: testAccNetboxAvailableIPAddressesConfig(testPrefix, "node-0.example.com", "renamed.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.#", "2"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.1.ip_address", "1.1.8.2/29"),
+					resource.TestCheckResourceAttr("netbox_available_ip_addresses.test", "address.1.dns_name", "renamed.example.com"),
+				),
+			},
+			{
+				ResourceName:      "netbox_available_ip_addresses.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					parent, ok := s.RootModule().Resources["netbox_prefix.test"]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", "netbox_prefix.test")
+					}
+					resource, ok := s.RootModule().Resources["netbox_available_ip_addresses.test"]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", "netbox_available_ip_addresses.test")
+					}
+
+					return fmt.Sprintf("prefix_id %s %s,%s", parent.Primary.ID, resource.Primary.Attributes["address.0.id"], resource.Primary.Attributes["address.1.id"]), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAvailableObjectsDiag(t *testing.T) {
+	err := &apiRequestError{
+		operation: "POST /ipam/prefixes/7/available-ips/",
+		code:      http.StatusConflict,
+		payload:   map[string]interface{}{"detail": "An insufficient number of IP addresses are available within prefix 10.0.0.0/30 (3 requested, 2 available)"},
+	}
+
+	diags := availableObjectsDiag(err, resourceNetboxAvailableIPAddresses().TestResourceData(), "prefix 7")
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "prefix 7 is exhausted", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "3 requested, 2 available")
+}
+
+func TestResourceNetboxAvailableIPAddressesCreate(t *testing.T) {
+	var requests [][]map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/ipam/prefixes/7/available-ips/":
+			var body []map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			requests = append(requests, body)
+			if len(body) > 2 {
+				w.WriteHeader(http.StatusConflict)
+				json.NewEncoder(w).Encode(map[string]string{"detail": "An insufficient number of IP addresses are available within prefix 10.0.0.0/30 (3 requested, 2 available)"})
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode([]map[string]interface{}{
+				{"id": 11, "address": "10.0.0.1/30", "dns_name": body[0]["dns_name"]},
+				{"id": 12, "address": "10.0.0.2/30", "dns_name": body[1]["dns_name"]},
+			})
+		case "GET /api/ipam/ip-addresses/":
+			assert.Equal(t, []string{"11", "12"}, r.URL.Query()["id"])
+			json.NewEncoder(w).Encode(map[string]interface{}{"count": 2, "results": []map[string]interface{}{
+				{"id": 12, "address": "10.0.0.2/30", "dns_name": "b.example.com", "status": map[string]string{"value": "active"}},
+				{"id": 11, "address": "10.0.0.1/30", "dns_name": "a.example.com", "status": map[string]string{"value": "active"}},
+			}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	netboxClient, err := config.Client()
+	require.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient, defaultTags: schema.NewSet(schema.HashString, nil)}
+
+	res := Provider().ResourcesMap["netbox_available_ip_addresses"]
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"prefix_id": 7,
+		"address": []interface{}{
+			map[string]interface{}{"dns_name": "a.example.com"},
+			map[string]interface{}{"dns_name": "b.example.com"},
+		},
+	})
+
+	diags := resourceNetboxAvailableIPAddressesCreate(context.Background(), d, api)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Len(t, requests, 1)
+	assert.Equal(t, "a.example.com", requests[0][0]["dns_name"])
+	assert.Equal(t, "active", requests[0][1]["status"])
+	assert.Equal(t, "11", d.Id())
+	assert.Equal(t, "10.0.0.1/30", d.Get("address.0.ip_address"))
+	assert.Equal(t, "b.example.com", d.Get("address.1.dns_name"))
+	assert.Equal(t, 12, d.Get("address.1.id"))
+	assert.Equal(t, []interface{}{"10.0.0.1/30", "10.0.0.2/30"}, d.Get("ip_addresses"))
+
+	d = schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"prefix_id": 7,
+		"address":   []interface{}{map[string]interface{}{}, map[string]interface{}{}, map[string]interface{}{}},
+	})
+	diags = resourceNetboxAvailableIPAddressesCreate(context.Background(), d, api)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "prefix 7 is exhausted", diags[0].Summary)
+	assert.Equal(t, "", d.Id())
+}
+
+func TestResourceNetboxAvailableIPAddressesReallocateDeleted(t *testing.T) {
+	var allocated []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/ipam/prefixes/7/available-ips/":
+			json.NewDecoder(r.Body).Decode(&allocated)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode([]map[string]interface{}{
+				{"id": 13, "address": "10.0.0.3/29", "dns_name": allocated[0]["dns_name"]},
+			})
+		case "GET /api/ipam/ip-addresses/":
+			// address 11 was deleted outside of Terraform
+			results := []map[string]interface{}{
+				{"id": 12, "address": "10.0.0.2/29", "dns_name": "b.example.com", "status": map[string]string{"value": "active"}},
+			}
+			if len(allocated) > 0 {
+				results = append(results, map[string]interface{}{"id": 13, "address": "10.0.0.3/29", "dns_name": "a.example.com", "status": map[string]string{"value": "active"}})
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"count": len(results), "results": results})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	netboxClient, err := config.Client()
+	require.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient, defaultTags: schema.NewSet(schema.HashString, nil)}
+
+	res := Provider().ResourcesMap["netbox_available_ip_addresses"]
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"prefix_id": 7,
+		"address": []interface{}{
+			map[string]interface{}{"dns_name": "a.example.com", "id": 11},
+			map[string]interface{}{"dns_name": "b.example.com", "id": 12},
+		},
+	})
+	d.SetId("11")
+
+	diags := resourceNetboxAvailableIPAddressesRead(context.Background(), d, api)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "11", d.Id())
+	assert.Equal(t, 0, d.Get("address.0.id"))
+	assert.Equal(t, 12, d.Get("address.1.id"))
+	assert.Equal(t, "10.0.0.2/29", d.Get("address.1.ip_address"))
+
+	// apply the refreshed state without configuration changes
+	d = res.Data(d.State())
+	diags = resourceNetboxAvailableIPAddressesUpdate(context.Background(), d, api)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Len(t, allocated, 1)
+	assert.Equal(t, "a.example.com", allocated[0]["dns_name"])
+	assert.Equal(t, "13", d.Id())
+	assert.Equal(t, 13, d.Get("address.0.id"))
+	assert.Equal(t, 12, d.Get("address.1.id"))
+	assert.Equal(t, []interface{}{"10.0.0.3/29", "10.0.0.2/29"}, d.Get("ip_addresses"))
+}
+
+func TestResourceNetboxAvailableIPAddressesParseImport(t *testing.T) {
+	parentKey, parentID, ids, err := resourceNetboxAvailableIPAddressesParseImport("ip_range_id 7 11,12,13")
+	require.NoError(t, err)
+	assert.Equal(t, "ip_range_id", parentKey)
+	assert.Equal(t, 7, parentID)
+	assert.Equal(t, []int{11, 12, 13}, ids)
+
+	for _, importStr := range []string{"11", "prefix 7 11", "prefix_id 7", "prefix_id x 11", "prefix_id 7 11,x"} {
+		_, _, _, err := resourceNetboxAvailableIPAddressesParseImport(importStr)
+		assert.Error(t, err, importStr)
+	}
+}
@@ -2,30 +2,72 @@ package netbox
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxAvailablePrefix() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxAvailablePrefixCreate,
-		Read:   resourceNetboxPrefixRead,
-		Update: resourceNetboxPrefixUpdate,
-		Delete: resourceNetboxPrefixDelete,
+		CreateContext: resourceNetboxAvailablePrefixCreate,
+		ReadContext:   resourceNetboxPrefixRead,
+		UpdateContext: resourceNetboxPrefixUpdate,
+		DeleteContext: resourceNetboxPrefixDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):`,
 
 		Schema: map[string]*schema.Schema{
 			"parent_prefix_id": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"parent_prefix_id", "parent_prefix_ids", "parent_selector"},
+				Description:  "The prefix to allocate from. If `parent_prefix_ids` or `parent_selector` is given, this is the candidate the prefix was allocated from.",
+			},
+			"parent_prefix_ids": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MinItems:     1,
+				Elem:         &schema.Schema{Type: schema.TypeInt},
+				ExactlyOneOf: []string{"parent_prefix_id", "parent_prefix_ids", "parent_selector"},
+				Description:  "Candidate prefixes to allocate from, tried in the given order until one has enough space left. Changing the candidates does not move an allocated prefix.",
+			},
+			"parent_selector": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"parent_prefix_id", "parent_prefix_ids", "parent_selector"},
+				Description:  "Selects the candidate prefixes to allocate from. All prefixes matching every given filter are tried in the order Netbox returns them, i.e. by VRF and prefix. Changing the selector does not move an allocated prefix.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"vrf_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"site_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"tag": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Slug of a tag the candidates must have.",
+						},
+					},
+				},
 			},
 			"prefix_length": {
 				Type:         schema.TypeInt,
@@ -91,8 +133,9 @@ func resourceNetboxAvailablePrefix() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			customFieldsKey: customFieldsSchema,
-			tagsKey:         tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(c context.Context, rd *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -130,27 +173,107 @@ func resourceNetboxAvailablePrefixParseImport(importStr string) (int, string, in
 	return parentID, parts[1], prefixLength, nil
 }
 
-func resourceNetboxAvailablePrefixCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAvailablePrefixCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	parentPrefixID := int64(d.Get("parent_prefix_id").(int))
+	candidates, err := resourceNetboxAvailablePrefixCandidates(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(candidates) == 0 {
+		return diag.Errorf("no prefix matches parent_selector")
+	}
+
 	prefixLength := int64(d.Get("prefix_length").(int))
 	data := models.PrefixLength{
 		PrefixLength: &prefixLength,
 	}
-	if cf, ok := d.GetOk(customFieldsKey); ok {
-		data.CustomFields = cf
+	data.CustomFields = api.customFieldsFromResourceData(d)
+
+	// the candidates are tried in order, a conflict means that the candidate
+	// has no space left for a prefix of the requested length
+	var exhausted []string
+	for _, parentPrefixID := range candidates {
+		params := ipam.NewIpamPrefixesAvailablePrefixesCreateParams().WithContext(ctx).WithID(parentPrefixID).WithData(&data)
+
+		res, err := api.Ipam.IpamPrefixesAvailablePrefixesCreate(params, nil)
+		if err != nil {
+			var apiErr netboxAPIError
+			if errors.As(err, &apiErr) && apiErr.Code() == http.StatusConflict {
+				exhausted = append(exhausted, strconv.FormatInt(parentPrefixID, 10))
+				continue
+			}
+			return diagFromNetboxError(err, d)
+		}
+
+		payload := res.GetPayload()
+		d.SetId(strconv.FormatInt(payload.ID, 10))
+		d.Set("prefix", payload.Prefix)
+		d.Set("parent_prefix_id", parentPrefixID)
+
+		return resourceNetboxPrefixUpdate(ctx, d, m)
 	}
-	params := ipam.NewIpamPrefixesAvailablePrefixesCreateParams().WithID(parentPrefixID).WithData(&data)
 
-	res, err := api.Ipam.IpamPrefixesAvailablePrefixesCreate(params, nil)
-	if err != nil {
-		return err
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("No space left for a /%d prefix", prefixLength),
+		Detail:   fmt.Sprintf("All candidate parent prefixes are exhausted: %s", strings.Join(exhausted, ", ")),
+	}}
+}
+
+// resourceNetboxAvailablePrefixCandidates returns the IDs of the prefixes to
+// allocate from, in the order they should be tried.
+func resourceNetboxAvailablePrefixCandidates(ctx context.Context, api *providerState, d *schema.ResourceData) ([]int64, error) {
+	if parentPrefixID, ok := d.GetOk("parent_prefix_id"); ok {
+		return []int64{int64(parentPrefixID.(int))}, nil
+	}
+
+	if parentPrefixIDs, ok := d.GetOk("parent_prefix_ids"); ok {
+		var candidates []int64
+		for _, id := range parentPrefixIDs.([]interface{}) {
+			candidates = append(candidates, int64(id.(int)))
+		}
+		return candidates, nil
+	}
+
+	params := ipam.NewIpamPrefixesListParams().WithContext(ctx)
+
+	// only prefixes larger than the requested one can hold it
+	maskLength := float64(d.Get("prefix_length").(int) - 1)
+	params.MaskLengthLte = &maskLength
+
+	if selectors := d.Get("parent_selector").([]interface{}); len(selectors) > 0 && selectors[0] != nil {
+		selector := selectors[0].(map[string]interface{})
+		if roleID := selector["role_id"].(int); roleID != 0 {
+			params.RoleID = strToPtr(strconv.Itoa(roleID))
+		}
+		if vrfID := selector["vrf_id"].(int); vrfID != 0 {
+			params.VrfID = strToPtr(strconv.Itoa(vrfID))
+		}
+		if siteID := selector["site_id"].(int); siteID != 0 {
+			params.SiteID = strToPtr(strconv.Itoa(siteID))
+		}
+		if tag := selector["tag"].(string); tag != "" {
+			params.Tag = []string{tag}
+		}
 	}
 
-	payload := res.GetPayload()
-	d.SetId(strconv.FormatInt(payload.ID, 10))
-	d.Set("prefix", payload.Prefix)
+	prefixes, err := fetchAllPages(0, api.pageConcurrency, func(limit, offset int64) ([]*models.Prefix, int64, error) {
+		pageParams := *params
+		pageParams.Limit, pageParams.Offset = &limit, &offset
+		res, err := api.Ipam.IpamPrefixesList(&pageParams, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return resourceNetboxPrefixUpdate(d, m)
+	candidates := make([]int64, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		candidates = append(candidates, prefix.ID)
+	}
+	return candidates, nil
 }
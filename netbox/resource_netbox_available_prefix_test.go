@@ -308,6 +308,115 @@ resource "netbox_available_prefix" "with_site_group_id" {
 	})
 }
 
+func TestAccNetboxAvailablePrefix_candidates(t *testing.T) {
+	testSlug := "prefix_cand"
+	testName := testAccGetTestName(testSlug)
+	resourceName := "netbox_available_prefix.test"
+
+	dependencies := fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_prefix" "small" {
+  prefix = "16.2.0.0/25"
+  status = "container"
+  tags   = [netbox_tag.test.name]
+}
+
+resource "netbox_prefix" "large" {
+  prefix = "16.2.2.0/23"
+  status = "container"
+  tags   = [netbox_tag.test.name]
+}
+`, testName)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + `
+resource "netbox_available_prefix" "test" {
+  parent_prefix_ids = [netbox_prefix.small.id, netbox_prefix.large.id]
+  prefix_length     = 24
+  status            = "active"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prefix", "16.2.2.0/24"),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_prefix_id", "netbox_prefix.large", "id"),
+				),
+			},
+			{
+				// changing the candidates keeps the allocated prefix
+				Config: dependencies + `
+resource "netbox_available_prefix" "test" {
+  parent_prefix_ids = [netbox_prefix.large.id]
+  prefix_length     = 24
+  status            = "active"
+}`,
+				PlanOnly: true,
+			},
+			{
+				Config: dependencies + `
+resource "netbox_available_prefix" "test" {
+  prefix_length = 24
+  status        = "active"
+
+  parent_selector {
+    tag = netbox_tag.test.slug
+  }
+}
+
+resource "netbox_available_prefix" "second" {
+  prefix_length = 24
+  status        = "active"
+
+  parent_selector {
+    tag = netbox_tag.test.slug
+  }
+
+  depends_on = [netbox_available_prefix.test]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prefix", "16.2.2.0/24"),
+					resource.TestCheckResourceAttr("netbox_available_prefix.second", "prefix", "16.2.3.0/24"),
+					resource.TestCheckResourceAttrPair("netbox_available_prefix.second", "parent_prefix_id", "netbox_prefix.large", "id"),
+				),
+			},
+			{
+				Config: dependencies + `
+resource "netbox_available_prefix" "test" {
+  prefix_length = 24
+  status        = "active"
+
+  parent_selector {
+    tag = netbox_tag.test.slug
+  }
+}
+
+resource "netbox_available_prefix" "second" {
+  prefix_length = 24
+  status        = "active"
+
+  parent_selector {
+    tag = netbox_tag.test.slug
+  }
+}
+
+resource "netbox_available_prefix" "third" {
+  prefix_length = 24
+  status        = "active"
+
+  parent_selector {
+    tag = netbox_tag.test.slug
+  }
+}`,
+				ExpectError: regexp.MustCompile("All candidate parent prefixes are exhausted"),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_available_prefix", &resource.Sweeper{
 		Name:         "netbox_available_prefix",
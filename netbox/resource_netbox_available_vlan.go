@@ -1,19 +1,28 @@
 package netbox
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	log "github.com/sirupsen/logrus"
 )
 
 func resourceNetboxAvailableVLAN() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxAvailableVLANCreate,
-		Read:   resourceNetboxAvailableVLANRead,
-		Update: resourceNetboxAvailableVLANUpdate,
-		Delete: resourceNetboxAvailableVLANDelete,
+		CreateContext: resourceNetboxAvailableVLANCreate,
+		ReadContext:   resourceNetboxAvailableVLANRead,
+		UpdateContext: resourceNetboxAvailableVLANUpdate,
+		DeleteContext: resourceNetboxAvailableVLANDelete,
+		CustomizeDiff: resourceNetboxAvailableVLANCustomizeDiff,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):Per [the docs](https://netbox.readthedocs.io/en/stable/models/ipam/vlan/):
 
@@ -25,7 +34,7 @@ func resourceNetboxAvailableVLAN() *schema.Resource {
 > * Reserved
 > * Deprecated
 
-This resource will retrieve the next available VLAN ID from a given VLAN group (specified by ID).`,
+This resource will retrieve the next available VLAN ID from a given VLAN group (specified by ID). The allocation can be limited to a part of the VID ranges of the group with ` + "`vid_min`" + ` and ` + "`vid_max`" + ` or to a list of preferred VLAN IDs.`,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -60,22 +69,49 @@ This resource will retrieve the next available VLAN ID from a given VLAN group (
 				Type:     schema.TypeInt,
 				Computed: true, // it's auto-assigned by NetBox, not user-supplied
 			},
+			"vid_min": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, 4094),
+				ConflictsWith: []string{"preferred_vids"},
+				Description:   "Lowest VLAN ID to allocate. Changing it does not move an allocated VLAN.",
+			},
+			"vid_max": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, 4094),
+				ConflictsWith: []string{"preferred_vids"},
+				Description:   "Highest VLAN ID to allocate. Changing it does not move an allocated VLAN.",
+			},
+			"preferred_vids": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MinItems:      1,
+				Elem:          &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(1, 4094)},
+				ConflictsWith: []string{"vid_min", "vid_max"},
+				Description:   "VLAN IDs to allocate, tried in the given order. Changing them does not move an allocated VLAN.",
+			},
 			"comments": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
 
-func resourceNetboxAvailableVLANCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAvailableVLANCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	groupID := int64(d.Get("group_id").(int))
 
-	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	data := &models.WritableCreateAvailableVLAN{
 		Name:        strToPtr(d.Get("name").(string)),
@@ -87,10 +123,23 @@ func resourceNetboxAvailableVLANCreate(d *schema.ResourceData, m interface{}) er
 		Tags:        tags,
 	}
 
-	params := ipam.NewIpamVlanGroupsAvailableVlansCreateParams().WithID(groupID).WithData(data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+
+	_, hasMin := d.GetOk("vid_min")
+	_, hasMax := d.GetOk("vid_max")
+	_, hasPreferred := d.GetOk("preferred_vids")
+	if hasMin || hasMax || hasPreferred {
+		return resourceNetboxAvailableVLANCreateConstrained(ctx, d, m, groupID, data)
+	}
+
+	params := ipam.NewIpamVlanGroupsAvailableVlansCreateParams().WithContext(ctx).WithID(groupID).WithData(data)
 	resp, err := api.Ipam.IpamVlanGroupsAvailableVlansCreate(params, nil)
 	if err != nil {
-		return err
+		var apiErr netboxAPIError
+		if errors.As(err, &apiErr) && apiErr.Code() == http.StatusConflict {
+			return diag.Errorf("VLAN group %d has no available VLAN IDs left", groupID)
+		}
+		return diagFromNetboxError(err, d)
 	}
 
 	vlan := resp.Payload
@@ -98,13 +147,144 @@ func resourceNetboxAvailableVLANCreate(d *schema.ResourceData, m interface{}) er
 	d.Set("vid", vlan.Vid)
 	d.Set("name", vlan.Name)
 	d.Set("group_id", vlan.Group.ID)
-	return resourceNetboxAvailableVLANRead(d, m)
+	return resourceNetboxAvailableVLANRead(ctx, d, m)
+}
+
+// resourceNetboxAvailableVLANCreateConstrained creates a VLAN with the first
+// available VID matching vid_min and vid_max or preferred_vids. Netbox can only
+// allocate the lowest available VID itself, so the VID is picked from the list
+// of available VLANs instead. If another client takes the same VID in the
+// meantime, the next one is tried.
+func resourceNetboxAvailableVLANCreateConstrained(ctx context.Context, d *schema.ResourceData, m interface{}, groupID int64, available *models.WritableCreateAvailableVLAN) diag.Diagnostics {
+	api := m.(*providerState)
+
+	var candidates []int64
+	for _, vid := range d.Get("preferred_vids").([]interface{}) {
+		candidates = append(candidates, int64(vid.(int)))
+	}
+	vidMin := int64(d.Get("vid_min").(int))
+	vidMax := int64(d.Get("vid_max").(int))
+
+	const maxAttempts = 5
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		params := ipam.NewIpamVlanGroupsAvailableVlansListParams().WithContext(ctx).WithID(groupID)
+		res, err := api.Ipam.IpamVlanGroupsAvailableVlansList(params, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		vid := pickAvailableVID(res.GetPayload(), candidates, vidMin, vidMax)
+		if vid == 0 {
+			if len(candidates) > 0 {
+				return diag.Errorf("none of the preferred VLAN IDs %v is available in VLAN group %d", candidates, groupID)
+			}
+			return diag.Errorf("VLAN group %d has no available VLAN ID between %d and %d", groupID, max(vidMin, 1), vidMaxOrDefault(vidMax))
+		}
+
+		data := &models.WritableVLAN{
+			Name:         available.Name,
+			Description:  available.Description,
+			Tenant:       available.Tenant,
+			Site:         available.Site,
+			Group:        &groupID,
+			Role:         available.Role,
+			Status:       available.Status,
+			Vid:          &vid,
+			Tags:         available.Tags,
+			CustomFields: available.CustomFields,
+		}
+		createParams := ipam.NewIpamVlansCreateParams().WithContext(ctx).WithData(data)
+		resp, err := api.Ipam.IpamVlansCreate(createParams, nil)
+		if err != nil {
+			if isVIDConflict(err) && attempt < maxAttempts-1 {
+				// the VID may have been taken since it was listed as available
+				log.WithFields(log.Fields{
+					"group_id": groupID,
+					"vid":      vid,
+				}).Debug("Creating VLAN with available VID failed, retrying")
+				continue
+			}
+			return diagFromNetboxError(err, d)
+		}
+
+		d.SetId(strconv.FormatInt(resp.GetPayload().ID, 10))
+		return resourceNetboxAvailableVLANRead(ctx, d, m)
+	}
+	return diag.Errorf("could not allocate a VLAN ID in VLAN group %d", groupID)
+}
+
+// isVIDConflict reports whether Netbox rejected a VLAN because of its VID,
+// e.g. because the VID is already used in the VLAN group. Netbox reports the
+// uniqueness of the VID within the group as a non-field error.
+func isVIDConflict(err error) bool {
+	var apiErr netboxAPIError
+	if !errors.As(err, &apiErr) || apiErr.Code() != http.StatusBadRequest {
+		return false
+	}
+
+	fieldErrors := flattenNetboxErrors(apiErr.GetPayload())
+	if len(fieldErrors["vid"]) > 0 {
+		return true
+	}
+	for _, message := range fieldErrors[""] {
+		message = strings.ToLower(message)
+		if strings.Contains(message, "vid") || strings.Contains(message, "vlan id") {
+			return true
+		}
+	}
+	return false
+}
+
+// pickAvailableVID returns the first of the preferred VIDs that is available
+// or, without preferred VIDs, the lowest available VID between vidMin and
+// vidMax. A limit of 0 is not applied. It returns 0 if there is none.
+func pickAvailableVID(available []*models.AvailableVLAN, preferred []int64, vidMin, vidMax int64) int64 {
+	isAvailable := make(map[int64]bool, len(available))
+	for _, vlan := range available {
+		isAvailable[vlan.Vid] = true
+	}
+
+	if len(preferred) > 0 {
+		for _, vid := range preferred {
+			if isAvailable[vid] {
+				return vid
+			}
+		}
+		return 0
+	}
+
+	var lowest int64
+	for _, vlan := range available {
+		if (vidMin != 0 && vlan.Vid < vidMin) || (vidMax != 0 && vlan.Vid > vidMax) {
+			continue
+		}
+		if lowest == 0 || vlan.Vid < lowest {
+			lowest = vlan.Vid
+		}
+	}
+	return lowest
+}
+
+func resourceNetboxAvailableVLANCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	vidMin := d.Get("vid_min").(int)
+	vidMax := d.Get("vid_max").(int)
+	if vidMin != 0 && vidMax != 0 && vidMin > vidMax {
+		return fmt.Errorf("vid_min (%d) must not be greater than vid_max (%d)", vidMin, vidMax)
+	}
+	return nil
+}
+
+func vidMaxOrDefault(vidMax int64) int64 {
+	if vidMax == 0 {
+		return 4094
+	}
+	return vidMax
 }
 
-func resourceNetboxAvailableVLANRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAvailableVLANRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamVlansReadParams().WithID(id)
+	params := ipam.NewIpamVlansReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Ipam.IpamVlansRead(params, nil)
 	if err != nil {
@@ -116,7 +296,7 @@ func resourceNetboxAvailableVLANRead(d *schema.ResourceData, m interface{}) erro
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	vlan := res.GetPayload()
@@ -159,11 +339,12 @@ func resourceNetboxAvailableVLANRead(d *schema.ResourceData, m interface{}) erro
 	}
 
 	api.readTags(d, vlan.Tags)
+	api.readCustomFields(d, vlan.CustomFields)
 
 	return nil
 }
 
-func resourceNetboxAvailableVLANUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAvailableVLANUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -179,27 +360,28 @@ func resourceNetboxAvailableVLANUpdate(d *schema.ResourceData, m interface{}) er
 	}
 
 	var err_tags error
-	data.Tags, err_tags = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.Tags, err_tags = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err_tags != nil {
-		return err_tags
+		return diag.FromErr(err_tags)
 	}
 
-	params := ipam.NewIpamVlansUpdateParams().
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamVlansUpdateParams().WithContext(ctx).
 		WithID(id).
 		WithData(data)
 
 	_, err := api.Ipam.IpamVlansUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
-	return resourceNetboxAvailableVLANRead(d, m)
+	return resourceNetboxAvailableVLANRead(ctx, d, m)
 }
 
-func resourceNetboxAvailableVLANDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxAvailableVLANDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
-	params := ipam.NewIpamVlansDeleteParams().WithID(id)
+	params := ipam.NewIpamVlansDeleteParams().WithContext(ctx).WithID(id)
 	_, err := api.Ipam.IpamVlansDelete(params, nil)
 
 	if err != nil {
@@ -207,7 +389,7 @@ func resourceNetboxAvailableVLANDelete(d *schema.ResourceData, m interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,13 +1,18 @@
 package netbox
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strconv"
 	"testing"
 
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestAccNetboxAvailableVLAN_basic verifies that a basic available VLAN can be
@@ -303,7 +308,7 @@ resource "netbox_available_vlan" "vlan2" {
   status   = "active"
 }
 `,
-				ExpectError: regexp.MustCompile(`(?i)(409|400|no available vlans|must be greater than or equal to)`),
+				ExpectError: regexp.MustCompile(`(?i)(409|400|no available vlan|must be greater than or equal to)`),
 			},
 		},
 	})
@@ -345,3 +350,120 @@ resource "netbox_available_vlan" "vlan" {
 		},
 	})
 }
+
+// TestAccNetboxAvailableVLAN_constraints verifies that VLANs are allocated
+// within vid_min and vid_max or from the preferred VIDs, that an allocated VLAN
+// can be imported and that a clear error is returned if no VID matches.
+func TestAccNetboxAvailableVLAN_constraints(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "netbox_vlan_group" "group" {
+  name       = "Constrained VLAN Group"
+  slug       = "constrained"
+  vid_ranges = [[600, 620]]
+}
+
+resource "netbox_available_vlan" "range" {
+  name     = "constrained-range"
+  group_id = netbox_vlan_group.group.id
+  status   = "active"
+  vid_min  = 610
+  vid_max  = 615
+}
+
+resource "netbox_available_vlan" "preferred" {
+  name           = "constrained-preferred"
+  group_id       = netbox_vlan_group.group.id
+  status         = "active"
+  preferred_vids = [700, 618, 619]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_vlan.range", "vid", "610"),
+					resource.TestCheckResourceAttr("netbox_available_vlan.preferred", "vid", "618"),
+				),
+			},
+			{
+				ResourceName:            "netbox_available_vlan.range",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"vid_min", "vid_max"},
+			},
+			{
+				Config: `
+resource "netbox_vlan_group" "group" {
+  name       = "Constrained VLAN Group"
+  slug       = "constrained"
+  vid_ranges = [[600, 620]]
+}
+
+resource "netbox_available_vlan" "range" {
+  name     = "constrained-range"
+  group_id = netbox_vlan_group.group.id
+  status   = "active"
+  vid_min  = 610
+  vid_max  = 615
+}
+
+resource "netbox_available_vlan" "preferred" {
+  name           = "constrained-preferred"
+  group_id       = netbox_vlan_group.group.id
+  status         = "active"
+  preferred_vids = [700, 618, 619]
+}
+
+resource "netbox_available_vlan" "outside" {
+  name     = "constrained-outside"
+  group_id = netbox_vlan_group.group.id
+  status   = "active"
+  vid_min  = 621
+}
+`,
+				ExpectError: regexp.MustCompile(`has no available VLAN ID between 621 and 4094`),
+			},
+		},
+	})
+}
+
+func TestPickAvailableVID(t *testing.T) {
+	available := []*models.AvailableVLAN{{Vid: 5}, {Vid: 6}, {Vid: 10}, {Vid: 12}}
+
+	assert.Equal(t, int64(5), pickAvailableVID(available, nil, 0, 0))
+	assert.Equal(t, int64(10), pickAvailableVID(available, nil, 7, 0))
+	assert.Equal(t, int64(5), pickAvailableVID(available, nil, 0, 11))
+	assert.Equal(t, int64(10), pickAvailableVID(available, nil, 7, 11))
+	assert.Equal(t, int64(0), pickAvailableVID(available, nil, 7, 9))
+	assert.Equal(t, int64(12), pickAvailableVID(available, []int64{7, 12, 5}, 0, 0))
+	assert.Equal(t, int64(0), pickAvailableVID(available, []int64{7, 8}, 0, 0))
+	assert.Equal(t, int64(0), pickAvailableVID(nil, nil, 0, 0))
+}
+
+func TestIsVIDConflict(t *testing.T) {
+	newErr := func(code int, payload interface{}) error {
+		return &apiRequestError{operation: "POST /ipam/vlans/", code: code, payload: payload}
+	}
+
+	assert.True(t, isVIDConflict(newErr(http.StatusBadRequest, map[string]interface{}{"vid": []interface{}{"VLAN with this Group and VID already exists."}})))
+	assert.True(t, isVIDConflict(newErr(http.StatusBadRequest, map[string]interface{}{"__all__": []interface{}{"VLAN ID must be unique within group."}})))
+	assert.True(t, isVIDConflict(newErr(http.StatusBadRequest, map[string]interface{}{"non_field_errors": []interface{}{"The fields group, vid must make a unique set."}})))
+	assert.False(t, isVIDConflict(newErr(http.StatusBadRequest, map[string]interface{}{"name": []interface{}{"This field may not be blank."}})))
+	assert.False(t, isVIDConflict(newErr(http.StatusBadRequest, map[string]interface{}{"tenant": []interface{}{"Related object not found using the provided numeric ID: 7"}})))
+	assert.False(t, isVIDConflict(newErr(http.StatusConflict, map[string]interface{}{"vid": []interface{}{"taken"}})))
+}
+
+func TestResourceNetboxAvailableVLANCustomizeDiff(t *testing.T) {
+	res := resourceNetboxAvailableVLAN()
+	diff := func(config map[string]interface{}) error {
+		_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+		return err
+	}
+
+	require.NoError(t, diff(map[string]interface{}{"name": "test", "vid_min": 10, "vid_max": 10}))
+	require.NoError(t, diff(map[string]interface{}{"name": "test", "vid_min": 10}))
+	err := diff(map[string]interface{}{"name": "test", "vid_min": 20, "vid_max": 10})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vid_min (20) must not be greater than vid_max (10)")
+}
@@ -0,0 +1,168 @@
+package netbox
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxBranch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxBranchCreate,
+		ReadContext:   resourceNetboxBranchRead,
+		UpdateContext: resourceNetboxBranchUpdate,
+		DeleteContext: resourceNetboxBranchDelete,
+
+		Description: `:meta:subcategory:Branching:From the [official documentation](https://docs.netboxlabs.com/netbox-extensions/branching/):
+
+> Branching enables users to create isolated copies of the NetBox database, where changes can be made and reviewed before being merged into the main schema.
+
+This resource requires the netbox-branching plugin. Changes can be made in a branch by setting the ` + "`branch`" + ` attribute of the provider.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sync_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any value. Whenever it changes, the branch is synchronized with the changes made in the main schema since its creation or last sync.",
+			},
+			"merge": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the branch is merged into the main schema. A merged branch can not be changed anymore.",
+			},
+			"schema_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the database schema of the branch. It is sent in the `X-NetBox-Branch` header to make requests in the branch.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_sync": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceNetboxBranchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+	}
+
+	var branch netboxBranch
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, branchesPath, nil, data, &branch); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(branch.ID, 10))
+
+	// a new branch is provisioned in the background
+	if _, err := waitForBranch(ctx, api.Transport, branch.ID, []string{"new", "provisioning"}, "ready", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("merge").(bool) {
+		if err := runBranchJob(ctx, api.Transport, branch.ID, "merge", d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNetboxBranchRead(ctx, d, m)
+}
+
+func resourceNetboxBranchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var branch netboxBranch
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, branchPath(id), nil, nil, &branch); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", branch.Name)
+	d.Set("description", branch.Description)
+	d.Set("schema_id", branch.SchemaID)
+	d.Set("status", branch.Status.Value)
+	if branch.LastSync != nil {
+		d.Set("last_sync", *branch.LastSync)
+	} else {
+		d.Set("last_sync", nil)
+	}
+
+	return nil
+}
+
+func resourceNetboxBranchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if d.HasChanges("name", "description") {
+		data := map[string]interface{}{
+			"name":        d.Get("name").(string),
+			"description": d.Get("description").(string),
+		}
+		if err := netboxAPIRequest(ctx, api.Transport, http.MethodPatch, branchPath(id), nil, data, nil); err != nil {
+			return diagFromNetboxError(err, d)
+		}
+	}
+
+	if d.HasChange("sync_trigger") && d.Get("status").(string) != "merged" {
+		if err := runBranchJob(ctx, api.Transport, id, "sync", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("merge").(bool) && d.Get("status").(string) != "merged" {
+		if err := runBranchJob(ctx, api.Transport, id, "merge", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNetboxBranchRead(ctx, d, m)
+}
+
+func resourceNetboxBranchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodDelete, branchPath(id), nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+
+	return nil
+}
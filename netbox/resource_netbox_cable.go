@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxCable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxCableCreate,
-		Read:   resourceNetboxCableRead,
-		Update: resourceNetboxCableUpdate,
-		Delete: resourceNetboxCableDelete,
+		CreateContext: resourceNetboxCableCreate,
+		ReadContext:   resourceNetboxCableRead,
+		UpdateContext: resourceNetboxCableUpdate,
+		DeleteContext: resourceNetboxCableDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/cable/):
 
@@ -79,8 +81,9 @@ func resourceNetboxCable() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -88,7 +91,7 @@ func resourceNetboxCable() *schema.Resource {
 	}
 }
 
-func resourceNetboxCableCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCableCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableCable{
@@ -110,32 +113,29 @@ func resourceNetboxCableCreate(d *schema.ResourceData, m interface{}) error {
 	data.BTerminations = getGenericObjectsFromSchemaSet(bTerminations)
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimCablesCreateParams().WithData(&data)
+	params := dcim.NewDcimCablesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimCablesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxCableRead(d, m)
+	return resourceNetboxCableRead(ctx, d, m)
 }
 
-func resourceNetboxCableRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCableRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimCablesReadParams().WithID(id)
+	params := dcim.NewDcimCablesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimCablesRead(params, nil)
 
@@ -146,7 +146,7 @@ func resourceNetboxCableRead(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	cable := res.GetPayload()
@@ -181,16 +181,13 @@ func resourceNetboxCableRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", cable.Description)
 	d.Set("comments", cable.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxCableUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCableUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -214,35 +211,32 @@ func resourceNetboxCableUpdate(d *schema.ResourceData, m interface{}) error {
 	data.BTerminations = getGenericObjectsFromSchemaSet(bTerminations)
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimCablesPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimCablesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimCablesPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxCableRead(d, m)
+	return resourceNetboxCableRead(ctx, d, m)
 }
 
-func resourceNetboxCableDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCableDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimCablesDeleteParams().WithID(id)
+	params := dcim.NewDcimCablesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimCablesDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,10 +1,12 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/circuits"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -13,10 +15,10 @@ var resourceNetboxCircuitStatusOptions = []string{"planned", "provisioning", "ac
 
 func resourceNetboxCircuit() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxCircuitCreate,
-		Read:   resourceNetboxCircuitRead,
-		Update: resourceNetboxCircuitUpdate,
-		Delete: resourceNetboxCircuitDelete,
+		CreateContext: resourceNetboxCircuitCreate,
+		ReadContext:   resourceNetboxCircuitRead,
+		UpdateContext: resourceNetboxCircuitUpdate,
+		DeleteContext: resourceNetboxCircuitDelete,
 
 		Description: `:meta:subcategory:Circuits:From the [official documentation](https://docs.netbox.dev/en/stable/features/circuits/#circuits_1):
 
@@ -47,6 +49,8 @@ func resourceNetboxCircuit() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(resourceNetboxCircuitStatusOptions, false),
 				Description:  buildValidValueDescription(resourceNetboxCircuitStatusOptions),
 			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -54,7 +58,7 @@ func resourceNetboxCircuit() *schema.Resource {
 	}
 }
 
-func resourceNetboxCircuitCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableCircuit{}
@@ -81,22 +85,23 @@ func resourceNetboxCircuitCreate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags = []*models.NestedTag{}
 
-	params := circuits.NewCircuitsCircuitsCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := circuits.NewCircuitsCircuitsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Circuits.CircuitsCircuitsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxCircuitRead(d, m)
+	return resourceNetboxCircuitRead(ctx, d, m)
 }
 
-func resourceNetboxCircuitRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := circuits.NewCircuitsCircuitsReadParams().WithID(id)
+	params := circuits.NewCircuitsCircuitsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Circuits.CircuitsCircuitsRead(params, nil)
 
@@ -109,7 +114,7 @@ func resourceNetboxCircuitRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("cid", res.GetPayload().Cid)
@@ -132,11 +137,12 @@ func resourceNetboxCircuitRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 
 	return nil
 }
 
-func resourceNetboxCircuitUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -164,21 +170,22 @@ func resourceNetboxCircuitUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Tags = []*models.NestedTag{}
 
-	params := circuits.NewCircuitsCircuitsPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := circuits.NewCircuitsCircuitsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Circuits.CircuitsCircuitsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxCircuitRead(d, m)
+	return resourceNetboxCircuitRead(ctx, d, m)
 }
 
-func resourceNetboxCircuitDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := circuits.NewCircuitsCircuitsDeleteParams().WithID(id)
+	params := circuits.NewCircuitsCircuitsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Circuits.CircuitsCircuitsDelete(params, nil)
 	if err != nil {
@@ -188,7 +195,7 @@ func resourceNetboxCircuitDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
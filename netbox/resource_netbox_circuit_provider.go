@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/circuits"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxCircuitProvider() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxCircuitProviderCreate,
-		Read:   resourceNetboxCircuitProviderRead,
-		Update: resourceNetboxCircuitProviderUpdate,
-		Delete: resourceNetboxCircuitProviderDelete,
+		CreateContext: resourceNetboxCircuitProviderCreate,
+		ReadContext:   resourceNetboxCircuitProviderRead,
+		UpdateContext: resourceNetboxCircuitProviderUpdate,
+		DeleteContext: resourceNetboxCircuitProviderDelete,
 
 		Description: `:meta:subcategory:Circuits:From the [official documentation](https://docs.netbox.dev/en/stable/features/circuits/#providers):
 
@@ -33,6 +35,8 @@ func resourceNetboxCircuitProvider() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -40,7 +44,7 @@ func resourceNetboxCircuitProvider() *schema.Resource {
 	}
 }
 
-func resourceNetboxCircuitProviderCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitProviderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableProvider{}
@@ -59,22 +63,23 @@ func resourceNetboxCircuitProviderCreate(d *schema.ResourceData, m interface{})
 	data.Tags = []*models.NestedTag{}
 	data.Asns = []int64{}
 
-	params := circuits.NewCircuitsProvidersCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := circuits.NewCircuitsProvidersCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Circuits.CircuitsProvidersCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxCircuitProviderRead(d, m)
+	return resourceNetboxCircuitProviderRead(ctx, d, m)
 }
 
-func resourceNetboxCircuitProviderRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitProviderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := circuits.NewCircuitsProvidersReadParams().WithID(id)
+	params := circuits.NewCircuitsProvidersReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Circuits.CircuitsProvidersRead(params, nil)
 
@@ -87,16 +92,17 @@ func resourceNetboxCircuitProviderRead(d *schema.ResourceData, m interface{}) er
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", res.GetPayload().Name)
 	d.Set("slug", res.GetPayload().Slug)
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 
 	return nil
 }
 
-func resourceNetboxCircuitProviderUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitProviderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -116,25 +122,26 @@ func resourceNetboxCircuitProviderUpdate(d *schema.ResourceData, m interface{})
 	data.Tags = []*models.NestedTag{}
 	data.Asns = []int64{}
 
-	params := circuits.NewCircuitsProvidersPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := circuits.NewCircuitsProvidersPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Circuits.CircuitsProvidersPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxCircuitProviderRead(d, m)
+	return resourceNetboxCircuitProviderRead(ctx, d, m)
 }
 
-func resourceNetboxCircuitProviderDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitProviderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := circuits.NewCircuitsProvidersDeleteParams().WithID(id)
+	params := circuits.NewCircuitsProvidersDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Circuits.CircuitsProvidersDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -49,6 +49,39 @@ resource "netbox_circuit_provider" "test" {
 	})
 }
 
+func TestAccNetboxCircuitProvider_customFields(t *testing.T) {
+	testSlug := "circuit_prov_cf"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["circuits.provider"]
+}
+
+resource "netbox_circuit_provider" "test" {
+  name          = "%[2]s"
+  custom_fields = {"${netbox_custom_field.test.name}" = "81"}
+}`, testField, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_circuit_provider.test", "custom_fields."+testField, "81"),
+				),
+			},
+			{
+				ResourceName:      "netbox_circuit_provider.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_circuit_provider", &resource.Sweeper{
 		Name:         "netbox_circuit_provider",
@@ -1,10 +1,12 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/circuits"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -13,10 +15,10 @@ var resourceNetboxCircuitTerminationTermSideOptions = []string{"A", "Z"}
 
 func resourceNetboxCircuitTermination() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxCircuitTerminationCreate,
-		Read:   resourceNetboxCircuitTerminationRead,
-		Update: resourceNetboxCircuitTerminationUpdate,
-		Delete: resourceNetboxCircuitTerminationDelete,
+		CreateContext: resourceNetboxCircuitTerminationCreate,
+		ReadContext:   resourceNetboxCircuitTerminationRead,
+		UpdateContext: resourceNetboxCircuitTerminationUpdate,
+		DeleteContext: resourceNetboxCircuitTerminationDelete,
 
 		Description: `:meta:subcategory:Circuits:From the [official documentation](https://docs.netbox.dev/en/stable/features/circuits/#circuit-terminations):
 
@@ -68,8 +70,9 @@ func resourceNetboxCircuitTermination() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(resourceNetboxCircuitTerminationTermSideOptions, false),
 				Description:  buildValidValueDescription(resourceNetboxCircuitTerminationTermSideOptions),
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -77,7 +80,7 @@ func resourceNetboxCircuitTermination() *schema.Resource {
 	}
 }
 
-func resourceNetboxCircuitTerminationCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitTerminationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableCircuitTermination{}
@@ -128,32 +131,29 @@ func resourceNetboxCircuitTerminationCreate(d *schema.ResourceData, m interface{
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := circuits.NewCircuitsCircuitTerminationsCreateParams().WithData(&data)
+	params := circuits.NewCircuitsCircuitTerminationsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Circuits.CircuitsCircuitTerminationsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxCircuitTerminationRead(d, m)
+	return resourceNetboxCircuitTerminationRead(ctx, d, m)
 }
 
-func resourceNetboxCircuitTerminationRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitTerminationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := circuits.NewCircuitsCircuitTerminationsReadParams().WithID(id)
+	params := circuits.NewCircuitsCircuitTerminationsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Circuits.CircuitsCircuitTerminationsRead(params, nil)
 
@@ -166,7 +166,7 @@ func resourceNetboxCircuitTerminationRead(d *schema.ResourceData, m interface{})
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	term := res.GetPayload()
@@ -215,15 +215,12 @@ func resourceNetboxCircuitTerminationRead(d *schema.ResourceData, m interface{})
 
 	api.readTags(d, term.Tags)
 
-	cf := getCustomFields(term.CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, term.CustomFields)
 
 	return nil
 }
 
-func resourceNetboxCircuitTerminationUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitTerminationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -275,31 +272,28 @@ func resourceNetboxCircuitTerminationUpdate(d *schema.ResourceData, m interface{
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := circuits.NewCircuitsCircuitTerminationsPartialUpdateParams().WithID(id).WithData(&data)
+	params := circuits.NewCircuitsCircuitTerminationsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Circuits.CircuitsCircuitTerminationsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxCircuitTerminationRead(d, m)
+	return resourceNetboxCircuitTerminationRead(ctx, d, m)
 }
 
-func resourceNetboxCircuitTerminationDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCircuitTerminationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := circuits.NewCircuitsCircuitTerminationsDeleteParams().WithID(id)
+	params := circuits.NewCircuitsCircuitTerminationsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Circuits.CircuitsCircuitTerminationsDelete(params, nil)
 	if err != nil {
@@ -309,7 +303,7 @@ func resourceNetboxCircuitTerminationDelete(d *schema.ResourceData, m interface{
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,19 +1,21 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/virtualization"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxClusterCreate,
-		Read:   resourceNetboxClusterRead,
-		Update: resourceNetboxClusterUpdate,
-		Delete: resourceNetboxClusterDelete,
+		CreateContext: resourceNetboxClusterCreate,
+		ReadContext:   resourceNetboxClusterRead,
+		UpdateContext: resourceNetboxClusterUpdate,
+		DeleteContext: resourceNetboxClusterDelete,
 
 		Description: `:meta:subcategory:Virtualization:From the [official documentation](https://netboxlabs.com/docs/netbox/models/virtualization/cluster/):
 > A cluster is a logical grouping of physical resources within which virtual machines run. Physical devices may be associated with clusters as hosts. This allows users to track on which host(s) a particular virtual machine may reside.`,
@@ -63,7 +65,9 @@ func resourceNetboxCluster() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -71,7 +75,7 @@ func resourceNetboxCluster() *schema.Resource {
 	}
 }
 
-func resourceNetboxClusterCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableCluster{}
@@ -118,26 +122,27 @@ func resourceNetboxClusterCreate(d *schema.ResourceData, m interface{}) error {
 		data.Tenant = &tenantID
 	}
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	data.Tags = tags
 
-	params := virtualization.NewVirtualizationClustersCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := virtualization.NewVirtualizationClustersCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Virtualization.VirtualizationClustersCreate(params, nil)
 	if err != nil {
 		//return errors.New(getTextFromError(err))
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxClusterRead(d, m)
+	return resourceNetboxClusterRead(ctx, d, m)
 }
 
-func resourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := virtualization.NewVirtualizationClustersReadParams().WithID(id)
+	params := virtualization.NewVirtualizationClustersReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Virtualization.VirtualizationClustersRead(params, nil)
 	if err != nil {
@@ -149,7 +154,7 @@ func resourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	cluster := res.GetPayload()
@@ -192,10 +197,11 @@ func resourceNetboxClusterRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	api.readTags(d, res.GetPayload().Tags)
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	return nil
 }
 
-func resourceNetboxClusterUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -243,24 +249,25 @@ func resourceNetboxClusterUpdate(d *schema.ResourceData, m interface{}) error {
 		data.ScopeID = nil
 	}
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	data.Tags = tags
 
-	params := virtualization.NewVirtualizationClustersPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := virtualization.NewVirtualizationClustersPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Virtualization.VirtualizationClustersPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxClusterRead(d, m)
+	return resourceNetboxClusterRead(ctx, d, m)
 }
 
-func resourceNetboxClusterDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := virtualization.NewVirtualizationClustersDeleteParams().WithID(id)
+	params := virtualization.NewVirtualizationClustersDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Virtualization.VirtualizationClustersDelete(params, nil)
 	if err != nil {
@@ -270,7 +277,7 @@ func resourceNetboxClusterDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -58,6 +58,39 @@ resource "netbox_cluster_type" "test" {
 	})
 }
 
+func TestAccNetboxClusterType_customFields(t *testing.T) {
+	testSlug := "cluster_type_cf"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["virtualization.clustertype"]
+}
+
+resource "netbox_cluster_type" "test" {
+  name          = "%[2]s"
+  custom_fields = {"${netbox_custom_field.test.name}" = "81"}
+}`, testField, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_cluster_type.test", "custom_fields."+testField, "81"),
+				),
+			},
+			{
+				ResourceName:      "netbox_cluster_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_cluster_type", &resource.Sweeper{
 		Name:         "netbox_cluster_type",
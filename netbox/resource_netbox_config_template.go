@@ -61,7 +61,7 @@ func resourceNetboxConfigTemplateCreate(ctx context.Context, d *schema.ResourceD
 	description := d.Get("description").(string)
 	templateCode := d.Get("template_code").(string)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 
 	data := models.WritableConfigTemplate{
 		Name:         &name,
@@ -83,11 +83,11 @@ func resourceNetboxConfigTemplateCreate(ctx context.Context, d *schema.ResourceD
 		data.EnvironmentParams = environmentParams
 	}
 
-	params := extras.NewExtrasConfigTemplatesCreateParams().WithData(&data)
+	params := extras.NewExtrasConfigTemplatesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Extras.ExtrasConfigTemplatesCreate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
@@ -101,7 +101,7 @@ func resourceNetboxConfigTemplateRead(ctx context.Context, d *schema.ResourceDat
 
 	var diags diag.Diagnostics
 
-	params := extras.NewExtrasConfigTemplatesReadParams().WithID(id)
+	params := extras.NewExtrasConfigTemplatesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Extras.ExtrasConfigTemplatesRead(params, nil)
 	if err != nil {
@@ -121,6 +121,7 @@ func resourceNetboxConfigTemplateRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("name", tmpl.Name)
 	d.Set("description", tmpl.Description)
 	d.Set("template_code", tmpl.TemplateCode)
+	api.readTags(d, tmpl.Tags)
 
 	if tmpl.EnvironmentParams != nil {
 		environmentParamsJSON, err := json.Marshal(tmpl.EnvironmentParams)
@@ -147,7 +148,7 @@ func resourceNetboxConfigTemplateUpdate(ctx context.Context, d *schema.ResourceD
 	description := d.Get("description").(string)
 	templateCode := d.Get("template_code").(string)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 
 	data := models.WritableConfigTemplate{
 		Name:         &name,
@@ -169,10 +170,10 @@ func resourceNetboxConfigTemplateUpdate(ctx context.Context, d *schema.ResourceD
 		data.EnvironmentParams = environmentParams
 	}
 
-	params := extras.NewExtrasConfigTemplatesUpdateParams().WithID(id).WithData(&data)
+	params := extras.NewExtrasConfigTemplatesUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err := api.Extras.ExtrasConfigTemplatesUpdate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	return diags
@@ -182,7 +183,7 @@ func resourceNetboxConfigTemplateDelete(ctx context.Context, d *schema.ResourceD
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := extras.NewExtrasConfigTemplatesDeleteParams().WithID(id)
+	params := extras.NewExtrasConfigTemplatesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Extras.ExtrasConfigTemplatesDelete(params, nil)
 	if err != nil {
@@ -192,7 +193,7 @@ func resourceNetboxConfigTemplateDelete(ctx context.Context, d *schema.ResourceD
 				return nil
 			}
 		}
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -82,10 +82,10 @@ func resourceConsolePortTemplateCreate(ctx context.Context, d *schema.ResourceDa
 	if moduleTypeID, ok := d.Get("module_type_id").(int); ok && moduleTypeID != 0 {
 		data.ModuleType = int64ToPtr(int64(moduleTypeID))
 	}
-	params := dcim.NewDcimConsolePortTemplatesCreateParams().WithData(&data)
+	params := dcim.NewDcimConsolePortTemplatesCreateParams().WithContext(ctx).WithData(&data)
 	res, err := api.Dcim.DcimConsolePortTemplatesCreate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
@@ -99,7 +99,7 @@ func resourceConsolePortTemplateRead(ctx context.Context, d *schema.ResourceData
 
 	var diags diag.Diagnostics
 
-	params := dcim.NewDcimConsolePortTemplatesReadParams().WithID(id)
+	params := dcim.NewDcimConsolePortTemplatesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimConsolePortTemplatesRead(params, nil)
 	if err != nil {
@@ -164,10 +164,10 @@ func resourceConsolePortTemplateUpdate(ctx context.Context, d *schema.ResourceDa
 		data.ModuleType = &moduleTypeID
 	}
 
-	params := dcim.NewDcimConsolePortTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimConsolePortTemplatesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err := api.Dcim.DcimConsolePortTemplatesPartialUpdate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	return diags
@@ -177,7 +177,7 @@ func resourceConsolePortTemplateDelete(ctx context.Context, d *schema.ResourceDa
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimConsolePortTemplatesDeleteParams().WithID(id)
+	params := dcim.NewDcimConsolePortTemplatesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimConsolePortTemplatesDelete(params, nil)
 	if err != nil {
@@ -187,7 +187,7 @@ func resourceConsolePortTemplateDelete(ctx context.Context, d *schema.ResourceDa
 				return nil
 			}
 		}
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
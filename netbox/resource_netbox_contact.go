@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxContact() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxContactCreate,
-		Read:   resourceNetboxContactRead,
-		Update: resourceNetboxContactUpdate,
-		Delete: resourceNetboxContactDelete,
+		CreateContext: resourceNetboxContactCreate,
+		ReadContext:   resourceNetboxContactRead,
+		UpdateContext: resourceNetboxContactUpdate,
+		DeleteContext: resourceNetboxContactDelete,
 
 		Description: `:meta:subcategory:Tenancy:From the [official documentation](https://docs.netbox.dev/en/stable/features/contacts/#contacts_1):
 
@@ -27,7 +29,9 @@ func resourceNetboxContact() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 			"group_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -47,7 +51,7 @@ func resourceNetboxContact() *schema.Resource {
 	}
 }
 
-func resourceNetboxContactCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
@@ -55,7 +59,7 @@ func resourceNetboxContactCreate(d *schema.ResourceData, m interface{}) error {
 	email := d.Get("email").(string)
 	groupID := int64(d.Get("group_id").(int))
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 
 	data := &models.WritableContact{}
 
@@ -68,22 +72,23 @@ func resourceNetboxContactCreate(d *schema.ResourceData, m interface{}) error {
 		data.Group = &groupID
 	}
 
-	params := tenancy.NewTenancyContactsCreateParams().WithData(data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := tenancy.NewTenancyContactsCreateParams().WithContext(ctx).WithData(data)
 
 	res, err := api.Tenancy.TenancyContactsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxContactRead(d, m)
+	return resourceNetboxContactRead(ctx, d, m)
 }
 
-func resourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := tenancy.NewTenancyContactsReadParams().WithID(id)
+	params := tenancy.NewTenancyContactsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Tenancy.TenancyContactsRead(params, nil)
 	if err != nil {
@@ -95,7 +100,7 @@ func resourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", res.GetPayload().Name)
@@ -104,11 +109,13 @@ func resourceNetboxContactRead(d *schema.ResourceData, m interface{}) error {
 	if res.GetPayload().Group != nil {
 		d.Set("group_id", res.GetPayload().Group.ID)
 	}
+	api.readTags(d, res.GetPayload().Tags)
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 
 	return nil
 }
 
-func resourceNetboxContactUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -119,7 +126,7 @@ func resourceNetboxContactUpdate(d *schema.ResourceData, m interface{}) error {
 	email := d.Get("email").(string)
 	groupID := int64(d.Get("group_id").(int))
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 
 	data.Name = &name
 	data.Tags = tags
@@ -129,21 +136,22 @@ func resourceNetboxContactUpdate(d *schema.ResourceData, m interface{}) error {
 		data.Group = &groupID
 	}
 
-	params := tenancy.NewTenancyContactsPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := tenancy.NewTenancyContactsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Tenancy.TenancyContactsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxContactRead(d, m)
+	return resourceNetboxContactRead(ctx, d, m)
 }
 
-func resourceNetboxContactDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := tenancy.NewTenancyContactsDeleteParams().WithID(id)
+	params := tenancy.NewTenancyContactsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Tenancy.TenancyContactsDelete(params, nil)
 	if err != nil {
@@ -153,7 +161,7 @@ func resourceNetboxContactDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,10 +1,12 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -13,10 +15,10 @@ var resourceNetboxContactAssignmentPriorityOptions = []string{"primary", "second
 
 func resourceNetboxContactAssignment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxContactAssignmentCreate,
-		Read:   resourceNetboxContactAssignmentRead,
-		Update: resourceNetboxContactAssignmentUpdate,
-		Delete: resourceNetboxContactAssignmentDelete,
+		CreateContext: resourceNetboxContactAssignmentCreate,
+		ReadContext:   resourceNetboxContactAssignmentRead,
+		UpdateContext: resourceNetboxContactAssignmentUpdate,
+		DeleteContext: resourceNetboxContactAssignmentDelete,
 
 		Description: `:meta:subcategory:Tenancy:From the [official documentation](https://docs.netbox.dev/en/stable/features/contacts#contactassignments_1):
 
@@ -52,7 +54,7 @@ func resourceNetboxContactAssignment() *schema.Resource {
 	}
 }
 
-func resourceNetboxContactAssignmentCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	contentType := d.Get("content_type").(string)
@@ -69,22 +71,22 @@ func resourceNetboxContactAssignmentCreate(d *schema.ResourceData, m interface{}
 	data.Role = &roleID
 	data.Priority = priority
 
-	params := tenancy.NewTenancyContactAssignmentsCreateParams().WithData(data)
+	params := tenancy.NewTenancyContactAssignmentsCreateParams().WithContext(ctx).WithData(data)
 
 	res, err := api.Tenancy.TenancyContactAssignmentsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxContactAssignmentRead(d, m)
+	return resourceNetboxContactAssignmentRead(ctx, d, m)
 }
 
-func resourceNetboxContactAssignmentRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := tenancy.NewTenancyContactAssignmentsReadParams().WithID(id)
+	params := tenancy.NewTenancyContactAssignmentsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Tenancy.TenancyContactAssignmentsRead(params, nil)
 	if err != nil {
@@ -96,7 +98,7 @@ func resourceNetboxContactAssignmentRead(d *schema.ResourceData, m interface{})
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("content_type", res.GetPayload().ObjectType)
@@ -117,7 +119,7 @@ func resourceNetboxContactAssignmentRead(d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceNetboxContactAssignmentUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -141,21 +143,21 @@ func resourceNetboxContactAssignmentUpdate(d *schema.ResourceData, m interface{}
 	}
 	data.Priority = priority
 
-	params := tenancy.NewTenancyContactAssignmentsPartialUpdateParams().WithID(id).WithData(&data)
+	params := tenancy.NewTenancyContactAssignmentsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Tenancy.TenancyContactAssignmentsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxContactAssignmentRead(d, m)
+	return resourceNetboxContactAssignmentRead(ctx, d, m)
 }
 
-func resourceNetboxContactAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := tenancy.NewTenancyContactAssignmentsDeleteParams().WithID(id)
+	params := tenancy.NewTenancyContactAssignmentsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Tenancy.TenancyContactAssignmentsDelete(params, nil)
 	if err != nil {
@@ -165,7 +167,7 @@ func resourceNetboxContactAssignmentDelete(d *schema.ResourceData, m interface{}
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxContactGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxContactGroupCreate,
-		Read:   resourceNetboxContactGroupRead,
-		Update: resourceNetboxContactGroupUpdate,
-		Delete: resourceNetboxContactGroupDelete,
+		CreateContext: resourceNetboxContactGroupCreate,
+		ReadContext:   resourceNetboxContactGroupRead,
+		UpdateContext: resourceNetboxContactGroupUpdate,
+		DeleteContext: resourceNetboxContactGroupDelete,
 
 		Description: `:meta:subcategory:Tenancy:From the [official documentation](https://docs.netbox.dev/en/stable/features/contacts/#contact-groups):
 
@@ -39,6 +41,8 @@ func resourceNetboxContactGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -46,7 +50,7 @@ func resourceNetboxContactGroup() *schema.Resource {
 	}
 }
 
-func resourceNetboxContactGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
@@ -72,23 +76,24 @@ func resourceNetboxContactGroupCreate(d *schema.ResourceData, m interface{}) err
 		data.Parent = &parentID
 	}
 
-	params := tenancy.NewTenancyContactGroupsCreateParams().WithData(data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := tenancy.NewTenancyContactGroupsCreateParams().WithContext(ctx).WithData(data)
 
 	res, err := api.Tenancy.TenancyContactGroupsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxContactGroupRead(d, m)
+	return resourceNetboxContactGroupRead(ctx, d, m)
 }
 
-func resourceNetboxContactGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
-	params := tenancy.NewTenancyContactGroupsReadParams().WithID(id)
+	params := tenancy.NewTenancyContactGroupsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Tenancy.TenancyContactGroupsRead(params, nil)
 	if err != nil {
@@ -100,7 +105,7 @@ func resourceNetboxContactGroupRead(d *schema.ResourceData, m interface{}) error
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", res.GetPayload().Name)
@@ -109,10 +114,11 @@ func resourceNetboxContactGroupRead(d *schema.ResourceData, m interface{}) error
 	if res.GetPayload().Parent != nil {
 		d.Set("parent", res.GetPayload().Parent.ID)
 	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	return nil
 }
 
-func resourceNetboxContactGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -139,25 +145,26 @@ func resourceNetboxContactGroupUpdate(d *schema.ResourceData, m interface{}) err
 	if parentID != 0 {
 		data.Parent = &parentID
 	}
-	params := tenancy.NewTenancyContactGroupsPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := tenancy.NewTenancyContactGroupsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Tenancy.TenancyContactGroupsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxContactGroupRead(d, m)
+	return resourceNetboxContactGroupRead(ctx, d, m)
 }
 
-func resourceNetboxContactGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := tenancy.NewTenancyContactGroupsDeleteParams().WithID(id)
+	params := tenancy.NewTenancyContactGroupsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Tenancy.TenancyContactGroupsDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
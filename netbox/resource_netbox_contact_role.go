@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/tenancy"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxContactRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxContactRoleCreate,
-		Read:   resourceNetboxContactRoleRead,
-		Update: resourceNetboxContactRoleUpdate,
-		Delete: resourceNetboxContactRoleDelete,
+		CreateContext: resourceNetboxContactRoleCreate,
+		ReadContext:   resourceNetboxContactRoleRead,
+		UpdateContext: resourceNetboxContactRoleUpdate,
+		DeleteContext: resourceNetboxContactRoleDelete,
 
 		Description: `:meta:subcategory:Tenancy:From the [official documentation](https://docs.netbox.dev/en/stable/features/contacts/#contactroles):
 
@@ -31,6 +33,8 @@ func resourceNetboxContactRole() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -38,7 +42,7 @@ func resourceNetboxContactRole() *schema.Resource {
 	}
 }
 
-func resourceNetboxContactRoleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
@@ -56,22 +60,23 @@ func resourceNetboxContactRoleCreate(d *schema.ResourceData, m interface{}) erro
 	data.Name = &name
 	data.Tags = []*models.NestedTag{}
 
-	params := tenancy.NewTenancyContactRolesCreateParams().WithData(data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := tenancy.NewTenancyContactRolesCreateParams().WithContext(ctx).WithData(data)
 
 	res, err := api.Tenancy.TenancyContactRolesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxContactRoleRead(d, m)
+	return resourceNetboxContactRoleRead(ctx, d, m)
 }
 
-func resourceNetboxContactRoleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := tenancy.NewTenancyContactRolesReadParams().WithID(id)
+	params := tenancy.NewTenancyContactRolesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Tenancy.TenancyContactRolesRead(params, nil)
 
@@ -84,17 +89,18 @@ func resourceNetboxContactRoleRead(d *schema.ResourceData, m interface{}) error
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	contactrole := res.GetPayload()
 	d.Set("name", contactrole.Name)
 	d.Set("slug", contactrole.Slug)
+	api.readCustomFields(d, contactrole.CustomFields)
 
 	return nil
 }
 
-func resourceNetboxContactRoleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -112,21 +118,22 @@ func resourceNetboxContactRoleUpdate(d *schema.ResourceData, m interface{}) erro
 	data.Name = &name
 	data.Tags = []*models.NestedTag{}
 
-	params := tenancy.NewTenancyContactRolesPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := tenancy.NewTenancyContactRolesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Tenancy.TenancyContactRolesPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxContactRoleRead(d, m)
+	return resourceNetboxContactRoleRead(ctx, d, m)
 }
 
-func resourceNetboxContactRoleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxContactRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := tenancy.NewTenancyContactRolesDeleteParams().WithID(id)
+	params := tenancy.NewTenancyContactRolesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Tenancy.TenancyContactRolesDelete(params, nil)
 	if err != nil {
@@ -136,7 +143,7 @@ func resourceNetboxContactRoleDelete(d *schema.ResourceData, m interface{}) erro
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
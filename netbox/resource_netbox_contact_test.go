@@ -104,6 +104,39 @@ resource "netbox_contact" "test_tags" {
 	})
 }
 
+func TestAccNetboxContact_customFields(t *testing.T) {
+	testSlug := "contact_cf"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["tenancy.contact"]
+}
+
+resource "netbox_contact" "test" {
+  name          = "%[2]s"
+  custom_fields = {"${netbox_custom_field.test.name}" = "81"}
+}`, testField, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_contact.test", "custom_fields."+testField, "81"),
+				),
+			},
+			{
+				ResourceName:      "netbox_contact.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_contact", &resource.Sweeper{
 		Name:         "netbox_contact",
@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceCustomField() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxCustomFieldCreate,
-		Read:   resourceNetboxCustomFieldRead,
-		Update: resourceNetboxCustomFieldUpdate,
-		Delete: resourceNetboxCustomFieldDelete,
+		CreateContext: resourceNetboxCustomFieldCreate,
+		ReadContext:   resourceNetboxCustomFieldRead,
+		UpdateContext: resourceNetboxCustomFieldUpdate,
+		DeleteContext: resourceNetboxCustomFieldDelete,
 
 		Description: `:meta:subcategory:Extras:From the [official documentation](https://docs.netbox.dev/en/stable/customization/custom-fields/#custom-fields):
 
@@ -32,15 +34,25 @@ func resourceCustomField() *schema.Resource {
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					models.CustomFieldTypeValueText,
+					models.CustomFieldTypeValueLongtext,
 					models.CustomFieldTypeValueInteger,
+					models.CustomFieldTypeValueDecimal,
 					models.CustomFieldTypeValueBoolean,
 					models.CustomFieldTypeValueDate,
 					models.CustomFieldTypeValueURL,
 					models.CustomFieldTypeValueSelect,
 					models.CustomFieldTypeValueMultiselect,
 					models.CustomFieldTypeValueJSON,
+					models.CustomFieldTypeValueObject,
+					models.CustomFieldTypeValueMultiobject,
 				}, false),
 			},
+			"related_object_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The type of the objects referenced by custom fields of type `object` or `multiobject`, e.g. `dcim.device`.",
+			},
 			"content_types": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -99,7 +111,7 @@ func resourceCustomField() *schema.Resource {
 	}
 }
 
-func resourceNetboxCustomFieldUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCustomFieldUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -121,6 +133,8 @@ func resourceNetboxCustomFieldUpdate(d *schema.ResourceData, m interface{}) erro
 		data.ChoiceSet = int64ToPtr(int64(choiceSet.(int)))
 	}
 
+	data.ObjectType = d.Get("related_object_type").(string)
+
 	ctypes, ok := d.GetOk("content_types")
 	if ok {
 		ctypes := ctypes.(*schema.Set).List()
@@ -140,18 +154,18 @@ func resourceNetboxCustomFieldUpdate(d *schema.ResourceData, m interface{}) erro
 		data.ValidationMinimum = int64ToPtr(int64(vmin.(int)))
 	}
 
-	params := extras.NewExtrasCustomFieldsUpdateParams().WithID(id).WithData(data)
+	params := extras.NewExtrasCustomFieldsUpdateParams().WithContext(ctx).WithID(id).WithData(data)
 	res, err := api.Extras.ExtrasCustomFieldsUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxCustomFieldRead(d, m)
+	return resourceNetboxCustomFieldRead(ctx, d, m)
 }
 
-func resourceNetboxCustomFieldCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCustomFieldCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := &models.WritableCustomField{
@@ -171,6 +185,8 @@ func resourceNetboxCustomFieldCreate(d *schema.ResourceData, m interface{}) erro
 		data.ChoiceSet = int64ToPtr(int64(choiceSet.(int)))
 	}
 
+	data.ObjectType = d.Get("related_object_type").(string)
+
 	ctypes, ok := d.GetOk("content_types")
 	if ok {
 		ctypes := ctypes.(*schema.Set).List()
@@ -190,35 +206,35 @@ func resourceNetboxCustomFieldCreate(d *schema.ResourceData, m interface{}) erro
 		data.ValidationMinimum = int64ToPtr(int64(vmin.(int)))
 	}
 
-	params := extras.NewExtrasCustomFieldsCreateParams().WithData(data)
+	params := extras.NewExtrasCustomFieldsCreateParams().WithContext(ctx).WithData(data)
 
 	res, err := api.Extras.ExtrasCustomFieldsCreate(params, nil)
 	if err != nil {
 		//return errors.New(getTextFromError(err))
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxCustomFieldRead(d, m)
+	return resourceNetboxCustomFieldRead(ctx, d, m)
 }
 
-func resourceNetboxCustomFieldRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCustomFieldRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := extras.NewExtrasCustomFieldsReadParams().WithID(id)
+	params := extras.NewExtrasCustomFieldsReadParams().WithContext(ctx).WithID(id)
 	res, err := api.Extras.ExtrasCustomFieldsRead(params, nil)
 	if err != nil {
 		errapi, ok := err.(*extras.ExtrasCustomFieldsReadDefault)
 		if !ok {
-			return err
+			return diag.FromErr(err)
 		}
 		errorcode := errapi.Code()
 		if errorcode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	customField := res.GetPayload()
@@ -226,6 +242,7 @@ func resourceNetboxCustomFieldRead(d *schema.ResourceData, m interface{}) error
 	d.Set("type", *customField.Type.Value)
 
 	d.Set("content_types", customField.ObjectTypes)
+	d.Set("related_object_type", customField.ObjectType)
 
 	choiceSet := customField.ChoiceSet
 	if choiceSet != nil {
@@ -249,10 +266,10 @@ func resourceNetboxCustomFieldRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNetboxCustomFieldDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCustomFieldDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := extras.NewExtrasCustomFieldsDeleteParams().WithID(id)
+	params := extras.NewExtrasCustomFieldsDeleteParams().WithContext(ctx).WithID(id)
 	_, err := api.Extras.ExtrasCustomFieldsDelete(params, nil)
 	if err != nil {
 		if errresp, ok := err.(*extras.ExtrasCustomFieldsDeleteDefault); ok {
@@ -261,7 +278,7 @@ func resourceNetboxCustomFieldDelete(d *schema.ResourceData, m interface{}) erro
 				d.SetId("")
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,11 +1,12 @@
 package netbox
 
 import (
-	"errors"
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -14,10 +15,10 @@ var resourceNetboxCustomFieldChoiceSetBaseChoicesOptions = []string{"IATA", "ISO
 
 func resourceNetboxCustomFieldChoiceSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxCustomFieldChoiceSetCreate,
-		Read:   resourceNetboxCustomFieldChoiceSetRead,
-		Update: resourceNetboxCustomFieldChoiceSetUpdate,
-		Delete: resourceNetboxCustomFieldChoiceSetDelete,
+		CreateContext: resourceNetboxCustomFieldChoiceSetCreate,
+		ReadContext:   resourceNetboxCustomFieldChoiceSetRead,
+		UpdateContext: resourceNetboxCustomFieldChoiceSetUpdate,
+		DeleteContext: resourceNetboxCustomFieldChoiceSetDelete,
 
 		Description: `:meta:subcategory:Extras:From the [official documentation](https://docs.netbox.dev/en/stable/models/extras/customfieldchoiceset/):
 
@@ -83,7 +84,7 @@ A choice set must define a base choice set and/or a set of arbitrary extra choic
 	}
 }
 
-func resourceNetboxCustomFieldChoiceSetCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCustomFieldChoiceSetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
@@ -101,29 +102,29 @@ func resourceNetboxCustomFieldChoiceSetCreate(d *schema.ResourceData, m interfac
 		for _, innerList := range extraChoices.([]interface{}) {
 			tmp := innerList.([]interface{})
 			if len(tmp) != 2 {
-				return errors.New("length of inner lists must be exactly two for custom field choice sets")
+				return diag.Errorf("length of inner lists must be exactly two for custom field choice sets")
 			}
 			extraChoiceListList = append(extraChoiceListList, []string{tmp[0].(string), tmp[1].(string)})
 		}
 		data.ExtraChoices = extraChoiceListList
 	}
 
-	params := extras.NewExtrasCustomFieldChoiceSetsCreateParams().WithData(&data)
+	params := extras.NewExtrasCustomFieldChoiceSetsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Extras.ExtrasCustomFieldChoiceSetsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxCustomFieldChoiceSetRead(d, m)
+	return resourceNetboxCustomFieldChoiceSetRead(ctx, d, m)
 }
 
-func resourceNetboxCustomFieldChoiceSetRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCustomFieldChoiceSetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := extras.NewExtrasCustomFieldChoiceSetsReadParams().WithID(id)
+	params := extras.NewExtrasCustomFieldChoiceSetsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Extras.ExtrasCustomFieldChoiceSetsRead(params, nil)
 
@@ -136,7 +137,7 @@ func resourceNetboxCustomFieldChoiceSetRead(d *schema.ResourceData, m interface{
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	choiceSet := res.GetPayload()
@@ -152,7 +153,7 @@ func resourceNetboxCustomFieldChoiceSetRead(d *schema.ResourceData, m interface{
 	return nil
 }
 
-func resourceNetboxCustomFieldChoiceSetUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCustomFieldChoiceSetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -172,28 +173,28 @@ func resourceNetboxCustomFieldChoiceSetUpdate(d *schema.ResourceData, m interfac
 		for _, innerList := range extraChoices.([]interface{}) {
 			tmp := innerList.([]interface{})
 			if len(tmp) != 2 {
-				return errors.New("length of inner lists must be exactly two for custom field choice sets")
+				return diag.Errorf("length of inner lists must be exactly two for custom field choice sets")
 			}
 			extraChoiceListList = append(extraChoiceListList, []string{tmp[0].(string), tmp[1].(string)})
 		}
 		data.ExtraChoices = extraChoiceListList
 	}
 
-	params := extras.NewExtrasCustomFieldChoiceSetsPartialUpdateParams().WithID(id).WithData(&data)
+	params := extras.NewExtrasCustomFieldChoiceSetsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Extras.ExtrasCustomFieldChoiceSetsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxCustomFieldChoiceSetRead(d, m)
+	return resourceNetboxCustomFieldChoiceSetRead(ctx, d, m)
 }
 
-func resourceNetboxCustomFieldChoiceSetDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxCustomFieldChoiceSetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := extras.NewExtrasCustomFieldChoiceSetsDeleteParams().WithID(id)
+	params := extras.NewExtrasCustomFieldChoiceSetsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Extras.ExtrasCustomFieldChoiceSetsDelete(params, nil)
 	if err != nil {
@@ -203,7 +204,7 @@ func resourceNetboxCustomFieldChoiceSetDelete(d *schema.ResourceData, m interfac
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
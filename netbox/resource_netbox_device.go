@@ -133,7 +133,8 @@ func resourceNetboxDevice() *schema.Resource {
 				Optional:    true,
 				Description: "This is best managed through the use of `jsonencode` and a map of settings.",
 			},
-			customFieldsKey: customFieldsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -234,22 +235,19 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	params := dcim.NewDcimDevicesCreateParams().WithData(&data)
+	params := dcim.NewDcimDevicesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimDevicesCreate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
@@ -257,9 +255,9 @@ func resourceNetboxDeviceCreate(ctx context.Context, d *schema.ResourceData, m i
 	if vcMaster, ok := d.GetOk("virtual_chassis_master"); ok {
 		var err error
 		if vcMaster.(bool) {
-			err = virtualChassisUpdateMaster(api, *data.VirtualChassis, &(res.GetPayload().ID))
+			err = virtualChassisUpdateMaster(ctx, api, *data.VirtualChassis, &(res.GetPayload().ID))
 		} else {
-			err = virtualChassisUpdateMaster(api, *data.VirtualChassis, nil)
+			err = virtualChassisUpdateMaster(ctx, api, *data.VirtualChassis, nil)
 		}
 		if err != nil {
 			return diag.FromErr(err)
@@ -276,7 +274,7 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
-	params := dcim.NewDcimDevicesReadParams().WithID(id)
+	params := dcim.NewDcimDevicesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimDevicesRead(params, nil)
 	if err != nil {
@@ -353,10 +351,7 @@ func resourceNetboxDeviceRead(ctx context.Context, d *schema.ResourceData, m int
 		d.Set("config_template_id", nil)
 	}
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 
 	d.Set("asset_tag", device.AssetTag)
 
@@ -484,13 +479,10 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -535,24 +527,24 @@ func resourceNetboxDeviceUpdate(ctx context.Context, d *schema.ResourceData, m i
 		data.Serial = serial
 	}
 
-	params := dcim.NewDcimDevicesUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimDevicesUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimDevicesUpdate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	if d.HasChange("virtual_chassis_master") && data.VirtualChassis != nil {
 		var err error
 		if vcMaster, ok := d.GetOk("virtual_chassis_master"); ok {
 			if vcMaster.(bool) {
-				err = virtualChassisUpdateMaster(api, *data.VirtualChassis, &id)
+				err = virtualChassisUpdateMaster(ctx, api, *data.VirtualChassis, &id)
 			} else {
-				err = virtualChassisUpdateMaster(api, *data.VirtualChassis, nil)
+				err = virtualChassisUpdateMaster(ctx, api, *data.VirtualChassis, nil)
 			}
 		} else {
 			// It was set before, but no longer set, remove it as master
-			err = virtualChassisUpdateMaster(api, *data.VirtualChassis, nil)
+			err = virtualChassisUpdateMaster(ctx, api, *data.VirtualChassis, nil)
 		}
 		if err != nil {
 			return diag.FromErr(err)
@@ -574,14 +566,14 @@ func resourceNetboxDeviceDelete(ctx context.Context, d *schema.ResourceData, m i
 	if virtualChassisIDValue, ok := d.GetOk("virtual_chassis_id"); ok {
 		if d.Get("virtual_chassis_master").(bool) {
 			virtualChassisID := int64(virtualChassisIDValue.(int))
-			err := virtualChassisUpdateMaster(api, virtualChassisID, nil)
+			err := virtualChassisUpdateMaster(ctx, api, virtualChassisID, nil)
 			if err != nil {
 				return diag.FromErr(err)
 			}
 		}
 	}
 
-	params := dcim.NewDcimDevicesDeleteParams().WithID(id)
+	params := dcim.NewDcimDevicesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimDevicesDelete(params, nil)
 	if err != nil {
@@ -591,7 +583,7 @@ func resourceNetboxDeviceDelete(ctx context.Context, d *schema.ResourceData, m i
 				return nil
 			}
 		}
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 	return diags
 }
@@ -1,19 +1,21 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxDeviceBay() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxDeviceBayCreate,
-		Read:   resourceNetboxDeviceBayRead,
-		Update: resourceNetboxDeviceBayUpdate,
-		Delete: resourceNetboxDeviceBayDelete,
+		CreateContext: resourceNetboxDeviceBayCreate,
+		ReadContext:   resourceNetboxDeviceBayRead,
+		UpdateContext: resourceNetboxDeviceBayUpdate,
+		DeleteContext: resourceNetboxDeviceBayDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/devicebay/):
 
@@ -40,8 +42,9 @@ func resourceNetboxDeviceBay() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -49,7 +52,7 @@ func resourceNetboxDeviceBay() *schema.Resource {
 	}
 }
 
-func resourceNetboxDeviceBayCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceBayCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableDeviceBay{
@@ -61,32 +64,29 @@ func resourceNetboxDeviceBayCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimDeviceBaysCreateParams().WithData(&data)
+	params := dcim.NewDcimDeviceBaysCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimDeviceBaysCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxDeviceBayRead(d, m)
+	return resourceNetboxDeviceBayRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceBayRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceBayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimDeviceBaysReadParams().WithID(id)
+	params := dcim.NewDcimDeviceBaysReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimDeviceBaysRead(params, nil)
 
@@ -97,7 +97,7 @@ func resourceNetboxDeviceBayRead(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	deviceBay := res.GetPayload()
@@ -115,16 +115,13 @@ func resourceNetboxDeviceBayRead(d *schema.ResourceData, m interface{}) error {
 	}
 	d.Set("description", deviceBay.Description)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxDeviceBayUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceBayUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -138,35 +135,32 @@ func resourceNetboxDeviceBayUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimDeviceBaysPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimDeviceBaysPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimDeviceBaysPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxDeviceBayRead(d, m)
+	return resourceNetboxDeviceBayRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceBayDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceBayDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimDeviceBaysDeleteParams().WithID(id)
+	params := dcim.NewDcimDeviceBaysDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimDeviceBaysDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -64,11 +64,11 @@ func resourceNetboxDeviceBayTemplateCreate(ctx context.Context, d *schema.Resour
 	if deviceTypeID, ok := d.Get("device_type_id").(int); ok && deviceTypeID != 0 {
 		data.DeviceType = int64ToPtr(int64(deviceTypeID))
 	}
-	params := dcim.NewDcimDeviceBayTemplatesCreateParams().WithData(&data)
+	params := dcim.NewDcimDeviceBayTemplatesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimDeviceBayTemplatesCreate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
@@ -82,7 +82,7 @@ func resourceNetboxDeviceBayTemplateRead(ctx context.Context, d *schema.Resource
 
 	var diags diag.Diagnostics
 
-	params := dcim.NewDcimDeviceBayTemplatesReadParams().WithID(id)
+	params := dcim.NewDcimDeviceBayTemplatesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimDeviceBayTemplatesRead(params, nil)
 	if err != nil {
@@ -129,10 +129,10 @@ func resourceNetboxDeviceBayTemplateUpdate(ctx context.Context, d *schema.Resour
 		data.DeviceType = &deviceTypeID
 	}
 
-	params := dcim.NewDcimDeviceBayTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimDeviceBayTemplatesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err := api.Dcim.DcimDeviceBayTemplatesPartialUpdate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	return diags
@@ -142,7 +142,7 @@ func resourceNetboxDeviceBayTemplateDelete(ctx context.Context, d *schema.Resour
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimDeviceBayTemplatesDeleteParams().WithID(id)
+	params := dcim.NewDcimDeviceBayTemplatesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimDeviceBayTemplatesDelete(params, nil)
 	if err != nil {
@@ -152,7 +152,7 @@ func resourceNetboxDeviceBayTemplateDelete(ctx context.Context, d *schema.Resour
 				return nil
 			}
 		}
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
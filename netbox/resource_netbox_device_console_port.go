@@ -1,19 +1,21 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxDeviceConsolePort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxDeviceConsolePortCreate,
-		Read:   resourceNetboxDeviceConsolePortRead,
-		Update: resourceNetboxDeviceConsolePortUpdate,
-		Delete: resourceNetboxDeviceConsolePortDelete,
+		CreateContext: resourceNetboxDeviceConsolePortCreate,
+		ReadContext:   resourceNetboxDeviceConsolePortRead,
+		UpdateContext: resourceNetboxDeviceConsolePortUpdate,
+		DeleteContext: resourceNetboxDeviceConsolePortDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/consoleport/):
 
@@ -55,8 +57,9 @@ func resourceNetboxDeviceConsolePort() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -64,7 +67,7 @@ func resourceNetboxDeviceConsolePort() *schema.Resource {
 	}
 }
 
-func resourceNetboxDeviceConsolePortCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceConsolePortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableConsolePort{
@@ -79,32 +82,29 @@ func resourceNetboxDeviceConsolePortCreate(d *schema.ResourceData, m interface{}
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimConsolePortsCreateParams().WithData(&data)
+	params := dcim.NewDcimConsolePortsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimConsolePortsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxDeviceConsolePortRead(d, m)
+	return resourceNetboxDeviceConsolePortRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceConsolePortRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceConsolePortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimConsolePortsReadParams().WithID(id)
+	params := dcim.NewDcimConsolePortsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimConsolePortsRead(params, nil)
 
@@ -114,7 +114,7 @@ func resourceNetboxDeviceConsolePortRead(d *schema.ResourceData, m interface{})
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	consolePort := res.GetPayload()
@@ -150,16 +150,13 @@ func resourceNetboxDeviceConsolePortRead(d *schema.ResourceData, m interface{})
 	d.Set("description", consolePort.Description)
 	d.Set("mark_connected", consolePort.MarkConnected)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxDeviceConsolePortUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceConsolePortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -176,35 +173,32 @@ func resourceNetboxDeviceConsolePortUpdate(d *schema.ResourceData, m interface{}
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimConsolePortsPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimConsolePortsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimConsolePortsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxDeviceConsolePortRead(d, m)
+	return resourceNetboxDeviceConsolePortRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceConsolePortDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceConsolePortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimConsolePortsDeleteParams().WithID(id)
+	params := dcim.NewDcimConsolePortsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimConsolePortsDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,19 +1,21 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxDeviceConsoleServerPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxDeviceConsoleServerPortCreate,
-		Read:   resourceNetboxDeviceConsoleServerPortRead,
-		Update: resourceNetboxDeviceConsoleServerPortUpdate,
-		Delete: resourceNetboxDeviceConsoleServerPortDelete,
+		CreateContext: resourceNetboxDeviceConsoleServerPortCreate,
+		ReadContext:   resourceNetboxDeviceConsoleServerPortRead,
+		UpdateContext: resourceNetboxDeviceConsoleServerPortUpdate,
+		DeleteContext: resourceNetboxDeviceConsoleServerPortDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/consoleserverport/):
 
@@ -55,8 +57,9 @@ func resourceNetboxDeviceConsoleServerPort() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -64,7 +67,7 @@ func resourceNetboxDeviceConsoleServerPort() *schema.Resource {
 	}
 }
 
-func resourceNetboxDeviceConsoleServerPortCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceConsoleServerPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableConsoleServerPort{
@@ -79,32 +82,29 @@ func resourceNetboxDeviceConsoleServerPortCreate(d *schema.ResourceData, m inter
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimConsoleServerPortsCreateParams().WithData(&data)
+	params := dcim.NewDcimConsoleServerPortsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimConsoleServerPortsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxDeviceConsoleServerPortRead(d, m)
+	return resourceNetboxDeviceConsoleServerPortRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceConsoleServerPortRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceConsoleServerPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimConsoleServerPortsReadParams().WithID(id)
+	params := dcim.NewDcimConsoleServerPortsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimConsoleServerPortsRead(params, nil)
 
@@ -114,7 +114,7 @@ func resourceNetboxDeviceConsoleServerPortRead(d *schema.ResourceData, m interfa
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	consoleServerPort := res.GetPayload()
@@ -150,16 +150,13 @@ func resourceNetboxDeviceConsoleServerPortRead(d *schema.ResourceData, m interfa
 	d.Set("description", consoleServerPort.Description)
 	d.Set("mark_connected", consoleServerPort.MarkConnected)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxDeviceConsoleServerPortUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceConsoleServerPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -176,35 +173,32 @@ func resourceNetboxDeviceConsoleServerPortUpdate(d *schema.ResourceData, m inter
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimConsoleServerPortsPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimConsoleServerPortsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimConsoleServerPortsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxDeviceConsoleServerPortRead(d, m)
+	return resourceNetboxDeviceConsoleServerPortRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceConsoleServerPortDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceConsoleServerPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimConsoleServerPortsDeleteParams().WithID(id)
+	params := dcim.NewDcimConsoleServerPortsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimConsoleServerPortsDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,19 +1,21 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxDeviceFrontPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxDeviceFrontPortCreate,
-		Read:   resourceNetboxDeviceFrontPortRead,
-		Update: resourceNetboxDeviceFrontPortUpdate,
-		Delete: resourceNetboxDeviceFrontPortDelete,
+		CreateContext: resourceNetboxDeviceFrontPortCreate,
+		ReadContext:   resourceNetboxDeviceFrontPortRead,
+		UpdateContext: resourceNetboxDeviceFrontPortUpdate,
+		DeleteContext: resourceNetboxDeviceFrontPortDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/frontport/):
 
@@ -62,8 +64,9 @@ func resourceNetboxDeviceFrontPort() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -71,7 +74,7 @@ func resourceNetboxDeviceFrontPort() *schema.Resource {
 	}
 }
 
-func resourceNetboxDeviceFrontPortCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceFrontPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableFrontPort{
@@ -88,32 +91,29 @@ func resourceNetboxDeviceFrontPortCreate(d *schema.ResourceData, m interface{})
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimFrontPortsCreateParams().WithData(&data)
+	params := dcim.NewDcimFrontPortsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimFrontPortsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxDeviceFrontPortRead(d, m)
+	return resourceNetboxDeviceFrontPortRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceFrontPortRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceFrontPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimFrontPortsReadParams().WithID(id)
+	params := dcim.NewDcimFrontPortsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimFrontPortsRead(params, nil)
 
@@ -124,7 +124,7 @@ func resourceNetboxDeviceFrontPortRead(d *schema.ResourceData, m interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	frontPort := res.GetPayload()
@@ -162,16 +162,13 @@ func resourceNetboxDeviceFrontPortRead(d *schema.ResourceData, m interface{}) er
 	d.Set("description", frontPort.Description)
 	d.Set("mark_connected", frontPort.MarkConnected)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxDeviceFrontPortUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceFrontPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -190,35 +187,32 @@ func resourceNetboxDeviceFrontPortUpdate(d *schema.ResourceData, m interface{})
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimFrontPortsPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimFrontPortsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimFrontPortsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxDeviceFrontPortRead(d, m)
+	return resourceNetboxDeviceFrontPortRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceFrontPortDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceFrontPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimFrontPortsDeleteParams().WithID(id)
+	params := dcim.NewDcimFrontPortsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimFrontPortsDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -103,7 +103,9 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 			"tagged_vlans": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -115,6 +117,12 @@ func resourceNetboxDeviceInterface() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"vdc_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the `netbox_virtual_device_context`s the interface is assigned to.",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -134,7 +142,7 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 	enabled := d.Get("enabled").(bool)
 	mgmtonly := d.Get("mgmtonly").(bool)
 	mode := d.Get("mode").(string)
-	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -153,7 +161,7 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 		TaggedVlans:  taggedVlans,
 		Device:       &deviceID,
 		WirelessLans: []int64{},
-		Vdcs:         []int64{},
+		Vdcs:         toInt64List(d.Get("vdc_ids")),
 	}
 	if lag, ok := d.Get("lag_device_interface_id").(int); ok && lag != 0 {
 		data.Lag = int64ToPtr(int64(lag))
@@ -171,11 +179,12 @@ func resourceNetboxDeviceInterfaceCreate(ctx context.Context, d *schema.Resource
 		data.UntaggedVlan = int64ToPtr(int64(untaggedVlan))
 	}
 
-	params := dcim.NewDcimInterfacesCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := dcim.NewDcimInterfacesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimInterfacesCreate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
@@ -189,7 +198,7 @@ func resourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceDa
 
 	var diags diag.Diagnostics
 
-	params := dcim.NewDcimInterfacesReadParams().WithID(id)
+	params := dcim.NewDcimInterfacesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimInterfacesRead(params, nil)
 	if err != nil {
@@ -215,8 +224,10 @@ func resourceNetboxDeviceInterfaceRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("mtu", iface.Mtu)
 	d.Set("speed", iface.Speed)
 	api.readTags(d, iface.Tags)
+	api.readCustomFields(d, iface.CustomFields)
 	d.Set("tagged_vlans", getIDsFromNestedVLANDevice(iface.TaggedVlans))
 	d.Set("device_id", iface.Device.ID)
+	d.Set("vdc_ids", getIDsFromNestedVirtualDeviceContext(iface.Vdcs))
 
 	if iface.Lag != nil {
 		d.Set("lag_device_interface_id", iface.Lag.ID)
@@ -263,7 +274,7 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 	enabled := d.Get("enabled").(bool)
 	mgmtonly := d.Get("mgmtonly").(bool)
 	mode := d.Get("mode").(string)
-	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -282,7 +293,7 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 		TaggedVlans:  taggedVlans,
 		Device:       &deviceID,
 		WirelessLans: []int64{},
-		Vdcs:         []int64{},
+		Vdcs:         toInt64List(d.Get("vdc_ids")),
 	}
 
 	if d.HasChange("lag_device_interface_id") {
@@ -306,10 +317,11 @@ func resourceNetboxDeviceInterfaceUpdate(ctx context.Context, d *schema.Resource
 		data.UntaggedVlan = &untaggedvlan
 	}
 
-	params := dcim.NewDcimInterfacesPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := dcim.NewDcimInterfacesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err = api.Dcim.DcimInterfacesPartialUpdate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	return diags
@@ -319,7 +331,7 @@ func resourceNetboxDeviceInterfaceDelete(ctx context.Context, d *schema.Resource
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimInterfacesDeleteParams().WithID(id)
+	params := dcim.NewDcimInterfacesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimInterfacesDelete(params, nil)
 	if err != nil {
@@ -329,7 +341,7 @@ func resourceNetboxDeviceInterfaceDelete(ctx context.Context, d *schema.Resource
 				return nil
 			}
 		}
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -341,3 +353,11 @@ func getIDsFromNestedVLANDevice(nestedvlans []*models.NestedVLAN) []int64 {
 	}
 	return vlans
 }
+
+func getIDsFromNestedVirtualDeviceContext(nestedVdcs []*models.NestedVirtualDeviceContext) []int64 {
+	var vdcs []int64
+	for _, vdc := range nestedVdcs {
+		vdcs = append(vdcs, vdc.ID)
+	}
+	return vdcs
+}
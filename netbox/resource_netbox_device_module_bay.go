@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxDeviceModuleBay() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxDeviceModuleBayCreate,
-		Read:   resourceNetboxDeviceModuleBayRead,
-		Update: resourceNetboxDeviceModuleBayUpdate,
-		Delete: resourceNetboxDeviceModuleBayDelete,
+		CreateContext: resourceNetboxDeviceModuleBayCreate,
+		ReadContext:   resourceNetboxDeviceModuleBayRead,
+		UpdateContext: resourceNetboxDeviceModuleBayUpdate,
+		DeleteContext: resourceNetboxDeviceModuleBayDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/modulebay/):
 
@@ -42,8 +44,9 @@ func resourceNetboxDeviceModuleBay() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -51,7 +54,7 @@ func resourceNetboxDeviceModuleBay() *schema.Resource {
 	}
 }
 
-func resourceNetboxDeviceModuleBayCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceModuleBayCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableModuleBay{
@@ -63,32 +66,29 @@ func resourceNetboxDeviceModuleBayCreate(d *schema.ResourceData, m interface{})
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimModuleBaysCreateParams().WithData(&data)
+	params := dcim.NewDcimModuleBaysCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimModuleBaysCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxDeviceModuleBayRead(d, m)
+	return resourceNetboxDeviceModuleBayRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceModuleBayRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceModuleBayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimModuleBaysReadParams().WithID(id)
+	params := dcim.NewDcimModuleBaysReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimModuleBaysRead(params, nil)
 
@@ -99,7 +99,7 @@ func resourceNetboxDeviceModuleBayRead(d *schema.ResourceData, m interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	moduleBay := res.GetPayload()
@@ -115,16 +115,13 @@ func resourceNetboxDeviceModuleBayRead(d *schema.ResourceData, m interface{}) er
 	d.Set("position", moduleBay.Position)
 	d.Set("description", moduleBay.Description)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxDeviceModuleBayUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceModuleBayUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -138,35 +135,32 @@ func resourceNetboxDeviceModuleBayUpdate(d *schema.ResourceData, m interface{})
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimModuleBaysPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimModuleBaysPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimModuleBaysPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxDeviceModuleBayRead(d, m)
+	return resourceNetboxDeviceModuleBayRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceModuleBayDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceModuleBayDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimModuleBaysDeleteParams().WithID(id)
+	params := dcim.NewDcimModuleBaysDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimModuleBaysDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxPowerFeed() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxPowerFeedCreate,
-		Read:   resourceNetboxPowerFeedRead,
-		Update: resourceNetboxPowerFeedUpdate,
-		Delete: resourceNetboxPowerFeedDelete,
+		CreateContext: resourceNetboxPowerFeedCreate,
+		ReadContext:   resourceNetboxPowerFeedRead,
+		UpdateContext: resourceNetboxPowerFeedUpdate,
+		DeleteContext: resourceNetboxPowerFeedDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/powerfeed/):
 
@@ -83,8 +85,9 @@ func resourceNetboxPowerFeed() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -92,7 +95,7 @@ func resourceNetboxPowerFeed() *schema.Resource {
 	}
 }
 
-func resourceNetboxPowerFeedCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxPowerFeedCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritablePowerFeed{
@@ -112,32 +115,29 @@ func resourceNetboxPowerFeedCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimPowerFeedsCreateParams().WithData(&data)
+	params := dcim.NewDcimPowerFeedsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimPowerFeedsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxPowerFeedRead(d, m)
+	return resourceNetboxPowerFeedRead(ctx, d, m)
 }
 
-func resourceNetboxPowerFeedRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxPowerFeedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimPowerFeedsReadParams().WithID(id)
+	params := dcim.NewDcimPowerFeedsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimPowerFeedsRead(params, nil)
 
@@ -148,7 +148,7 @@ func resourceNetboxPowerFeedRead(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerFeed := res.GetPayload()
@@ -199,16 +199,13 @@ func resourceNetboxPowerFeedRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", powerFeed.Description)
 	d.Set("comments", powerFeed.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxPowerFeedUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxPowerFeedUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -230,35 +227,32 @@ func resourceNetboxPowerFeedUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimPowerFeedsPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimPowerFeedsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimPowerFeedsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxPowerFeedRead(d, m)
+	return resourceNetboxPowerFeedRead(ctx, d, m)
 }
 
-func resourceNetboxPowerFeedDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxPowerFeedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimPowerFeedsDeleteParams().WithID(id)
+	params := dcim.NewDcimPowerFeedsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimPowerFeedsDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxDevicePowerOutlet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxDevicePowerOutletCreate,
-		Read:   resourceNetboxDevicePowerOutletRead,
-		Update: resourceNetboxDevicePowerOutletUpdate,
-		Delete: resourceNetboxDevicePowerOutletDelete,
+		CreateContext: resourceNetboxDevicePowerOutletCreate,
+		ReadContext:   resourceNetboxDevicePowerOutletRead,
+		UpdateContext: resourceNetboxDevicePowerOutletUpdate,
+		DeleteContext: resourceNetboxDevicePowerOutletDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/poweroutlet/):
 
@@ -63,8 +65,9 @@ For example, imagine a PDU with one power port which draws from a three-phase fe
 				Default:  false,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -72,7 +75,7 @@ For example, imagine a PDU with one power port which draws from a three-phase fe
 	}
 }
 
-func resourceNetboxDevicePowerOutletCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDevicePowerOutletCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritablePowerOutlet{
@@ -88,32 +91,29 @@ func resourceNetboxDevicePowerOutletCreate(d *schema.ResourceData, m interface{}
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimPowerOutletsCreateParams().WithData(&data)
+	params := dcim.NewDcimPowerOutletsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimPowerOutletsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxDevicePowerOutletRead(d, m)
+	return resourceNetboxDevicePowerOutletRead(ctx, d, m)
 }
 
-func resourceNetboxDevicePowerOutletRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDevicePowerOutletRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimPowerOutletsReadParams().WithID(id)
+	params := dcim.NewDcimPowerOutletsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimPowerOutletsRead(params, nil)
 
@@ -124,7 +124,7 @@ func resourceNetboxDevicePowerOutletRead(d *schema.ResourceData, m interface{})
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerOutlet := res.GetPayload()
@@ -166,16 +166,13 @@ func resourceNetboxDevicePowerOutletRead(d *schema.ResourceData, m interface{})
 	d.Set("description", powerOutlet.Description)
 	d.Set("mark_connected", powerOutlet.MarkConnected)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxDevicePowerOutletUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDevicePowerOutletUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -193,35 +190,32 @@ func resourceNetboxDevicePowerOutletUpdate(d *schema.ResourceData, m interface{}
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimPowerOutletsPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimPowerOutletsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimPowerOutletsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxDevicePowerOutletRead(d, m)
+	return resourceNetboxDevicePowerOutletRead(ctx, d, m)
 }
 
-func resourceNetboxDevicePowerOutletDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDevicePowerOutletDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimPowerOutletsDeleteParams().WithID(id)
+	params := dcim.NewDcimPowerOutletsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimPowerOutletsDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
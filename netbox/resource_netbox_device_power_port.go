@@ -1,19 +1,21 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxDevicePowerPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxDevicePowerPortCreate,
-		Read:   resourceNetboxDevicePowerPortRead,
-		Update: resourceNetboxDevicePowerPortUpdate,
-		Delete: resourceNetboxDevicePowerPortDelete,
+		CreateContext: resourceNetboxDevicePowerPortCreate,
+		ReadContext:   resourceNetboxDevicePowerPortRead,
+		UpdateContext: resourceNetboxDevicePowerPortUpdate,
+		DeleteContext: resourceNetboxDevicePowerPortDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/powerport/):
 
@@ -58,8 +60,9 @@ func resourceNetboxDevicePowerPort() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -67,7 +70,7 @@ func resourceNetboxDevicePowerPort() *schema.Resource {
 	}
 }
 
-func resourceNetboxDevicePowerPortCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDevicePowerPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritablePowerPort{
@@ -83,32 +86,29 @@ func resourceNetboxDevicePowerPortCreate(d *schema.ResourceData, m interface{})
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimPowerPortsCreateParams().WithData(&data)
+	params := dcim.NewDcimPowerPortsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimPowerPortsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxDevicePowerPortRead(d, m)
+	return resourceNetboxDevicePowerPortRead(ctx, d, m)
 }
 
-func resourceNetboxDevicePowerPortRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDevicePowerPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimPowerPortsReadParams().WithID(id)
+	params := dcim.NewDcimPowerPortsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimPowerPortsRead(params, nil)
 
@@ -119,7 +119,7 @@ func resourceNetboxDevicePowerPortRead(d *schema.ResourceData, m interface{}) er
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerPort := res.GetPayload()
@@ -152,16 +152,13 @@ func resourceNetboxDevicePowerPortRead(d *schema.ResourceData, m interface{}) er
 	d.Set("description", powerPort.Description)
 	d.Set("mark_connected", powerPort.MarkConnected)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxDevicePowerPortUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDevicePowerPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -179,35 +176,32 @@ func resourceNetboxDevicePowerPortUpdate(d *schema.ResourceData, m interface{})
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimPowerPortsPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimPowerPortsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimPowerPortsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxDevicePowerPortRead(d, m)
+	return resourceNetboxDevicePowerPortRead(ctx, d, m)
 }
 
-func resourceNetboxDevicePowerPortDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDevicePowerPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimPowerPortsDeleteParams().WithID(id)
+	params := dcim.NewDcimPowerPortsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimPowerPortsDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
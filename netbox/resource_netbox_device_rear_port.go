@@ -1,19 +1,21 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxDeviceRearPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxDeviceRearPortCreate,
-		Read:   resourceNetboxDeviceRearPortRead,
-		Update: resourceNetboxDeviceRearPortUpdate,
-		Delete: resourceNetboxDeviceRearPortDelete,
+		CreateContext: resourceNetboxDeviceRearPortCreate,
+		ReadContext:   resourceNetboxDeviceRearPortRead,
+		UpdateContext: resourceNetboxDeviceRearPortUpdate,
+		DeleteContext: resourceNetboxDeviceRearPortDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/rearport/):
 
@@ -58,8 +60,9 @@ func resourceNetboxDeviceRearPort() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -67,7 +70,7 @@ func resourceNetboxDeviceRearPort() *schema.Resource {
 	}
 }
 
-func resourceNetboxDeviceRearPortCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceRearPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableRearPort{
@@ -83,32 +86,29 @@ func resourceNetboxDeviceRearPortCreate(d *schema.ResourceData, m interface{}) e
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimRearPortsCreateParams().WithData(&data)
+	params := dcim.NewDcimRearPortsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimRearPortsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxDeviceRearPortRead(d, m)
+	return resourceNetboxDeviceRearPortRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceRearPortRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceRearPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimRearPortsReadParams().WithID(id)
+	params := dcim.NewDcimRearPortsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimRearPortsRead(params, nil)
 
@@ -119,7 +119,7 @@ func resourceNetboxDeviceRearPortRead(d *schema.ResourceData, m interface{}) err
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	rearPort := res.GetPayload()
@@ -151,16 +151,13 @@ func resourceNetboxDeviceRearPortRead(d *schema.ResourceData, m interface{}) err
 	d.Set("description", rearPort.Description)
 	d.Set("mark_connected", rearPort.MarkConnected)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxDeviceRearPortUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceRearPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -178,35 +175,32 @@ func resourceNetboxDeviceRearPortUpdate(d *schema.ResourceData, m interface{}) e
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimRearPortsPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimRearPortsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimRearPortsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxDeviceRearPortRead(d, m)
+	return resourceNetboxDeviceRearPortRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceRearPortDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceRearPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimRearPortsDeleteParams().WithID(id)
+	params := dcim.NewDcimRearPortsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimRearPortsDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxDeviceRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxDeviceRoleCreate,
-		Read:   resourceNetboxDeviceRoleRead,
-		Update: resourceNetboxDeviceRoleUpdate,
-		Delete: resourceNetboxDeviceRoleDelete,
+		CreateContext: resourceNetboxDeviceRoleCreate,
+		ReadContext:   resourceNetboxDeviceRoleRead,
+		UpdateContext: resourceNetboxDeviceRoleUpdate,
+		DeleteContext: resourceNetboxDeviceRoleDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/devices/#device-roles):
 
@@ -44,7 +46,9 @@ func resourceNetboxDeviceRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -52,7 +56,7 @@ func resourceNetboxDeviceRole() *schema.Resource {
 	}
 }
 
-func resourceNetboxDeviceRoleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
@@ -70,34 +74,35 @@ func resourceNetboxDeviceRoleCreate(d *schema.ResourceData, m interface{}) error
 	vmRole := d.Get("vm_role").(bool)
 	description := d.Get("description").(string)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 
-	params := dcim.NewDcimDeviceRolesCreateParams().WithData(
+	params := dcim.NewDcimDeviceRolesCreateParams().WithContext(ctx).WithData(
 		&models.DeviceRole{
-			Name:        &name,
-			Slug:        &slug,
-			Color:       color,
-			Description: description,
-			VMRole:      vmRole,
-			Tags:        tags,
+			Name:         &name,
+			Slug:         &slug,
+			Color:        color,
+			Description:  description,
+			VMRole:       vmRole,
+			Tags:         tags,
+			CustomFields: api.customFieldsFromResourceData(d),
 		},
 	)
 
 	res, err := api.Dcim.DcimDeviceRolesCreate(params, nil)
 	if err != nil {
 		//return errors.New(getTextFromError(err))
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxDeviceRoleRead(d, m)
+	return resourceNetboxDeviceRoleRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceRoleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimDeviceRolesReadParams().WithID(id)
+	params := dcim.NewDcimDeviceRolesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimDeviceRolesRead(params, nil)
 	if err != nil {
@@ -109,7 +114,7 @@ func resourceNetboxDeviceRoleRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", res.GetPayload().Name)
@@ -118,10 +123,11 @@ func resourceNetboxDeviceRoleRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("color_hex", res.GetPayload().Color)
 	d.Set("description", res.GetPayload().Description)
 	api.readTags(d, res.GetPayload().Tags)
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	return nil
 }
 
-func resourceNetboxDeviceRoleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -148,24 +154,25 @@ func resourceNetboxDeviceRoleUpdate(d *schema.ResourceData, m interface{}) error
 	data.Color = color
 	data.Description = description
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	data.Tags = tags
 
-	params := dcim.NewDcimDeviceRolesPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := dcim.NewDcimDeviceRolesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Dcim.DcimDeviceRolesPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxDeviceRoleRead(d, m)
+	return resourceNetboxDeviceRoleRead(ctx, d, m)
 }
 
-func resourceNetboxDeviceRoleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxDeviceRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimDeviceRolesDeleteParams().WithID(id)
+	params := dcim.NewDcimDeviceRolesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimDeviceRolesDelete(params, nil)
 	if err != nil {
@@ -175,7 +182,7 @@ func resourceNetboxDeviceRoleDelete(d *schema.ResourceData, m interface{}) error
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,11 +1,13 @@
 package netbox
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -14,10 +16,10 @@ var resourceNetboxEventRuleActionTypeOptions = []string{"webhook"}
 
 func resourceNetboxEventRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxEventRuleCreate,
-		Read:   resourceNetboxEventRuleRead,
-		Update: resourceNetboxEventRuleUpdate,
-		Delete: resourceNetboxEventRuleDelete,
+		CreateContext: resourceNetboxEventRuleCreate,
+		ReadContext:   resourceNetboxEventRuleRead,
+		UpdateContext: resourceNetboxEventRuleUpdate,
+		DeleteContext: resourceNetboxEventRuleDelete,
 
 		Description: `:meta:subcategory:Extras:From the [official documentation](https://docs.netbox.dev/en/stable/features/event-rules/):
 
@@ -70,7 +72,9 @@ func resourceNetboxEventRule() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -78,7 +82,7 @@ func resourceNetboxEventRule() *schema.Resource {
 	}
 }
 
-func resourceNetboxEventRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxEventRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := &models.WritableEventRule{}
@@ -102,7 +106,7 @@ func resourceNetboxEventRuleCreate(d *schema.ResourceData, m interface{}) error
 	data.Enabled = enabled
 	data.ActionObjectID = getOptionalInt(d, "action_object_id")
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	data.Tags = tags
 
 	ctypes := d.Get("content_types").(*schema.Set).List()
@@ -116,27 +120,28 @@ func resourceNetboxEventRuleCreate(d *schema.ResourceData, m interface{}) error
 		var conditions any
 		err := json.Unmarshal([]byte(conditionsData.(string)), &conditions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		data.Conditions = conditions
 	}
 
-	params := extras.NewExtrasEventRulesCreateParams().WithData(data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := extras.NewExtrasEventRulesCreateParams().WithContext(ctx).WithData(data)
 
 	res, err := api.Extras.ExtrasEventRulesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxEventRuleRead(d, m)
+	return resourceNetboxEventRuleRead(ctx, d, m)
 }
 
-func resourceNetboxEventRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxEventRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := extras.NewExtrasEventRulesReadParams().WithID(id)
+	params := extras.NewExtrasEventRulesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Extras.ExtrasEventRulesRead(params, nil)
 	if err != nil {
@@ -147,7 +152,7 @@ func resourceNetboxEventRuleRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	eventRule := res.GetPayload()
@@ -163,17 +168,18 @@ func resourceNetboxEventRuleRead(d *schema.ResourceData, m interface{}) error {
 	if eventRule.Conditions != nil {
 		conditions, err := json.Marshal(eventRule.Conditions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("conditions", string(conditions))
 	}
 
 	api.readTags(d, eventRule.Tags)
+	api.readCustomFields(d, eventRule.CustomFields)
 
 	return nil
 }
 
-func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxEventRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -202,12 +208,12 @@ func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error
 		var conditions any
 		err := json.Unmarshal([]byte(conditionsData.(string)), &conditions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		data.Conditions = conditions
 	}
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	data.Tags = tags
 
 	ctypes := d.Get("content_types").(*schema.Set).List()
@@ -217,21 +223,22 @@ func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error
 	}
 	data.ObjectTypes = objectTypes
 
-	params := extras.NewExtrasEventRulesUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := extras.NewExtrasEventRulesUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Extras.ExtrasEventRulesUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxEventRuleRead(d, m)
+	return resourceNetboxEventRuleRead(ctx, d, m)
 }
 
-func resourceNetboxEventRuleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxEventRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := extras.NewExtrasEventRulesDeleteParams().WithID(id)
+	params := extras.NewExtrasEventRulesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Extras.ExtrasEventRulesDelete(params, nil)
 	if err != nil {
@@ -241,7 +248,7 @@ func resourceNetboxEventRuleDelete(d *schema.ResourceData, m interface{}) error
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -0,0 +1,201 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxFHRPGroupProtocolOptions = []string{"vrrp2", "vrrp3", "carp", "clusterxl", "hsrp", "glbp", "other"}
+var resourceNetboxFHRPGroupAuthTypeOptions = []string{"plaintext", "md5"}
+
+func resourceNetboxFHRPGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxFHRPGroupCreate,
+		ReadContext:   resourceNetboxFHRPGroupRead,
+		UpdateContext: resourceNetboxFHRPGroupUpdate,
+		DeleteContext: resourceNetboxFHRPGroupDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/fhrpgroup/):
+
+> A first-hop redundancy protocol (FHRP) enables multiple physical interfaces to present a virtual IP address (VIP) in a redundant manner. Examples of such protocols include VRRP, HSRP and GLBP.
+
+Virtual IP addresses are assigned to a group with the ` + "`fhrp_group_id`" + ` of ` + "`netbox_ip_address`" + `, interfaces with ` + "`netbox_fhrp_group_assignment`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxFHRPGroupProtocolOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxFHRPGroupProtocolOptions),
+			},
+			"group_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 32767),
+				Description:  "The protocol specific group ID, e.g. the VRID of a VRRP group.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxFHRPGroupAuthTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxFHRPGroupAuthTypeOptions),
+			},
+			"auth_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_address_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the virtual IP addresses assigned to the group.",
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxFHRPGroupData(ctx context.Context, api *providerState, d *schema.ResourceData) (*models.FHRPGroup, error) {
+	data := &models.FHRPGroup{}
+
+	data.Protocol = strToPtr(d.Get("protocol").(string))
+	data.GroupID = int64ToPtr(int64(d.Get("group_id").(int)))
+	data.Name = d.Get("name").(string)
+	data.AuthType = d.Get("auth_type").(string)
+	data.AuthKey = d.Get("auth_key").(string)
+	data.Description = d.Get("description").(string)
+	data.Comments = d.Get("comments").(string)
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	return data, nil
+}
+
+func resourceNetboxFHRPGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxFHRPGroupData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := ipam.NewIpamFhrpGroupsCreateParams().WithContext(ctx).WithData(data)
+
+	res, err := api.Ipam.IpamFhrpGroupsCreate(params, nil)
+	if err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxFHRPGroupRead(ctx, d, m)
+}
+
+func resourceNetboxFHRPGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := ipam.NewIpamFhrpGroupsReadParams().WithContext(ctx).WithID(id)
+
+	res, err := api.Ipam.IpamFhrpGroupsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	group := res.GetPayload()
+	d.Set("protocol", group.Protocol)
+	d.Set("group_id", group.GroupID)
+	d.Set("name", group.Name)
+	d.Set("auth_type", group.AuthType)
+	d.Set("auth_key", group.AuthKey)
+	d.Set("description", group.Description)
+	d.Set("comments", group.Comments)
+
+	var ipAddressIDs []int64
+	for _, ipAddress := range group.IPAddresses {
+		ipAddressIDs = append(ipAddressIDs, ipAddress.ID)
+	}
+	d.Set("ip_address_ids", ipAddressIDs)
+
+	api.readTags(d, group.Tags)
+	api.readCustomFields(d, group.CustomFields)
+	return nil
+}
+
+func resourceNetboxFHRPGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxFHRPGroupData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := ipam.NewIpamFhrpGroupsUpdateParams().WithContext(ctx).WithID(id).WithData(data)
+
+	_, err = api.Ipam.IpamFhrpGroupsUpdate(params, nil)
+	if err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxFHRPGroupRead(ctx, d, m)
+}
+
+func resourceNetboxFHRPGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := ipam.NewIpamFhrpGroupsDeleteParams().WithContext(ctx).WithID(id)
+
+	_, err := api.Ipam.IpamFhrpGroupsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupsDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
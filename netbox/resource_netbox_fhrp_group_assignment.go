@@ -0,0 +1,141 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxFHRPGroupAssignmentInterfaceTypeOptions = []string{"dcim.interface", "virtualization.vminterface"}
+
+func resourceNetboxFHRPGroupAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxFHRPGroupAssignmentCreate,
+		ReadContext:   resourceNetboxFHRPGroupAssignmentRead,
+		UpdateContext: resourceNetboxFHRPGroupAssignmentUpdate,
+		DeleteContext: resourceNetboxFHRPGroupAssignmentDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/fhrpgroupassignment/):
+
+> Member device and VM interfaces can be assigned to FHRP groups, along with a numeric priority value. For instance, three interfaces, each belonging to a different router, may each be assigned to the same FHRP group to serve a shared virtual IP address. Each of these assignments would typically receive a different priority.`,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The ID of the `netbox_fhrp_group`.",
+			},
+			"interface_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxFHRPGroupAssignmentInterfaceTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxFHRPGroupAssignmentInterfaceTypeOptions),
+			},
+			"interface_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxFHRPGroupAssignmentData(d *schema.ResourceData) *models.WritableFHRPGroupAssignment {
+	data := &models.WritableFHRPGroupAssignment{}
+
+	data.Group = int64ToPtr(int64(d.Get("group_id").(int)))
+	data.InterfaceType = strToPtr(d.Get("interface_type").(string))
+	data.InterfaceID = int64ToPtr(int64(d.Get("interface_id").(int)))
+	data.Priority = int64ToPtr(int64(d.Get("priority").(int)))
+
+	return data
+}
+
+func resourceNetboxFHRPGroupAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	params := ipam.NewIpamFhrpGroupAssignmentsCreateParams().WithContext(ctx).WithData(resourceNetboxFHRPGroupAssignmentData(d))
+
+	res, err := api.Ipam.IpamFhrpGroupAssignmentsCreate(params, nil)
+	if err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxFHRPGroupAssignmentRead(ctx, d, m)
+}
+
+func resourceNetboxFHRPGroupAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := ipam.NewIpamFhrpGroupAssignmentsReadParams().WithContext(ctx).WithID(id)
+
+	res, err := api.Ipam.IpamFhrpGroupAssignmentsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupAssignmentsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	assignment := res.GetPayload()
+	if assignment.Group != nil {
+		d.Set("group_id", assignment.Group.ID)
+	}
+	d.Set("interface_type", assignment.InterfaceType)
+	d.Set("interface_id", assignment.InterfaceID)
+	d.Set("priority", assignment.Priority)
+	return nil
+}
+
+func resourceNetboxFHRPGroupAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := ipam.NewIpamFhrpGroupAssignmentsUpdateParams().WithContext(ctx).WithID(id).WithData(resourceNetboxFHRPGroupAssignmentData(d))
+
+	_, err := api.Ipam.IpamFhrpGroupAssignmentsUpdate(params, nil)
+	if err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxFHRPGroupAssignmentRead(ctx, d, m)
+}
+
+func resourceNetboxFHRPGroupAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := ipam.NewIpamFhrpGroupAssignmentsDeleteParams().WithContext(ctx).WithID(id)
+
+	_, err := api.Ipam.IpamFhrpGroupAssignmentsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupAssignmentsDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxFHRPGroupAssignmentFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_cluster_type" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_cluster" "test" {
+  name            = "%[1]s"
+  cluster_type_id = netbox_cluster_type.test.id
+}
+
+resource "netbox_virtual_machine" "test" {
+  name       = "%[1]s"
+  cluster_id = netbox_cluster.test.id
+}
+
+resource "netbox_interface" "test" {
+  name               = "eth0"
+  virtual_machine_id = netbox_virtual_machine.test.id
+}
+
+resource "netbox_fhrp_group" "test" {
+  name     = "%[1]s"
+  protocol = "vrrp2"
+  group_id = 7
+}
+`, testName)
+}
+
+func TestAccNetboxFHRPGroupAssignment_basic(t *testing.T) {
+	testSlug := "fhrp_assign"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxFHRPGroupAssignmentFullDependencies(testName) + `
+resource "netbox_fhrp_group_assignment" "test" {
+  group_id       = netbox_fhrp_group.test.id
+  interface_type = "virtualization.vminterface"
+  interface_id   = netbox_interface.test.id
+  priority       = 100
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_fhrp_group_assignment.test", "group_id", "netbox_fhrp_group.test", "id"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group_assignment.test", "interface_type", "virtualization.vminterface"),
+					resource.TestCheckResourceAttrPair("netbox_fhrp_group_assignment.test", "interface_id", "netbox_interface.test", "id"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group_assignment.test", "priority", "100"),
+				),
+			},
+			{
+				Config: testAccNetboxFHRPGroupAssignmentFullDependencies(testName) + `
+resource "netbox_fhrp_group_assignment" "test" {
+  group_id       = netbox_fhrp_group.test.id
+  interface_type = "virtualization.vminterface"
+  interface_id   = netbox_interface.test.id
+  priority       = 200
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_fhrp_group_assignment.test", "priority", "200"),
+				),
+			},
+			{
+				ResourceName:      "netbox_fhrp_group_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxFHRPGroup_basic(t *testing.T) {
+	testSlug := "fhrp_group"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_fhrp_group" "test" {
+  name        = "%[1]s"
+  protocol    = "hsrp"
+  group_id    = 42
+  auth_type   = "md5"
+  auth_key    = "secret"
+  description = "test description"
+  comments    = "test comments"
+  tags        = [netbox_tag.test.name]
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address    = "1.1.2.1/24"
+  status        = "active"
+  role          = "hsrp"
+  fhrp_group_id = netbox_fhrp_group.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "protocol", "hsrp"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "group_id", "42"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "auth_type", "md5"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "auth_key", "secret"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "description", "test description"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "comments", "test comments"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "tags.0", testName),
+				),
+			},
+			// the virtual IP addresses of the group need a refresh
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_fhrp_group.test", "ip_address_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_fhrp_group.test", "ip_address_ids.0", "netbox_ip_address.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_fhrp_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_fhrp_group", &resource.Sweeper{
+		Name:         "netbox_fhrp_group",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*providerState)
+			params := ipam.NewIpamFhrpGroupsListParams()
+			res, err := api.Ipam.IpamFhrpGroupsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, group := range res.GetPayload().Results {
+				if strings.HasPrefix(group.Name, testPrefix) {
+					deleteParams := ipam.NewIpamFhrpGroupsDeleteParams().WithID(group.ID)
+					_, err := api.Ipam.IpamFhrpGroupsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a fhrp group")
+				}
+			}
+			return nil
+		},
+	})
+}
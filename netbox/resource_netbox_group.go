@@ -1,19 +1,21 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/users"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxGroupCreate,
-		Read:   resourceNetboxGroupRead,
-		Update: resourceNetboxGroupUpdate,
-		Delete: resourceNetboxGroupDelete,
+		CreateContext: resourceNetboxGroupCreate,
+		ReadContext:   resourceNetboxGroupRead,
+		UpdateContext: resourceNetboxGroupUpdate,
+		DeleteContext: resourceNetboxGroupDelete,
 
 		Description: `:meta:subcategory:Authentication:This resource is used to manage groups.`,
 
@@ -33,7 +35,7 @@ func resourceNetboxGroup() *schema.Resource {
 		},
 	}
 }
-func resourceNetboxGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	data := models.Group{}
 
@@ -43,20 +45,20 @@ func resourceNetboxGroupCreate(d *schema.ResourceData, m interface{}) error {
 	data.Name = &name
 	data.Description = description
 
-	params := users.NewUsersGroupsCreateParams().WithData(&data)
+	params := users.NewUsersGroupsCreateParams().WithContext(ctx).WithData(&data)
 	res, err := api.Users.UsersGroupsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxGroupRead(d, m)
+	return resourceNetboxGroupRead(ctx, d, m)
 }
 
-func resourceNetboxGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := users.NewUsersGroupsReadParams().WithID(id)
+	params := users.NewUsersGroupsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Users.UsersGroupsRead(params, nil)
 	if err != nil {
@@ -68,7 +70,7 @@ func resourceNetboxGroupRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	if res.GetPayload().Name != nil {
@@ -79,7 +81,7 @@ func resourceNetboxGroupRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNetboxGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.Group{}
@@ -90,18 +92,18 @@ func resourceNetboxGroupUpdate(d *schema.ResourceData, m interface{}) error {
 	data.Name = &name
 	data.Description = description
 
-	params := users.NewUsersGroupsUpdateParams().WithID(id).WithData(&data)
+	params := users.NewUsersGroupsUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err := api.Users.UsersGroupsUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
-	return resourceNetboxGroupRead(d, m)
+	return resourceNetboxGroupRead(ctx, d, m)
 }
 
-func resourceNetboxGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := users.NewUsersGroupsDeleteParams().WithID(id)
+	params := users.NewUsersGroupsDeleteParams().WithContext(ctx).WithID(id)
 	_, err := api.Users.UsersGroupsDelete(params, nil)
 	if err != nil {
 		if errresp, ok := err.(*users.UsersGroupsDeleteDefault); ok {
@@ -110,7 +112,7 @@ func resourceNetboxGroupDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId("")
 	return nil
@@ -67,7 +67,9 @@ func resourceNetboxInterface() *schema.Resource {
 				Optional:   true,
 				Deprecated: "This attribute is not supported by netbox any longer. It will be removed in future versions of this provider.",
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 			"tagged_vlans": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -95,7 +97,7 @@ func resourceNetboxInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 	description := d.Get("description").(string)
 	enabled := d.Get("enabled").(bool)
 	mode := d.Get("mode").(string)
-	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -120,11 +122,12 @@ func resourceNetboxInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 	if untaggedVlan, ok := d.Get("untagged_vlan").(int); ok && untaggedVlan != 0 {
 		data.UntaggedVlan = int64ToPtr(int64(untaggedVlan))
 	}
-	params := virtualization.NewVirtualizationInterfacesCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := virtualization.NewVirtualizationInterfacesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Virtualization.VirtualizationInterfacesCreate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
@@ -138,7 +141,7 @@ func resourceNetboxInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 
 	var diags diag.Diagnostics
 
-	params := virtualization.NewVirtualizationInterfacesReadParams().WithID(id)
+	params := virtualization.NewVirtualizationInterfacesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Virtualization.VirtualizationInterfacesRead(params, nil)
 	if err != nil {
@@ -161,6 +164,7 @@ func resourceNetboxInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("mac_address", iface.MacAddress)
 	d.Set("mtu", iface.Mtu)
 	api.readTags(d, iface.Tags)
+	api.readCustomFields(d, iface.CustomFields)
 	d.Set("tagged_vlans", getIDsFromNestedVLAN(iface.TaggedVlans))
 	d.Set("virtual_machine_id", iface.VirtualMachine.ID)
 
@@ -185,7 +189,7 @@ func resourceNetboxInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 	description := d.Get("description").(string)
 	enabled := d.Get("enabled").(bool)
 	mode := d.Get("mode").(string)
-	tags, err := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -215,10 +219,11 @@ func resourceNetboxInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 		data.UntaggedVlan = &untaggedvlan
 	}
 
-	params := virtualization.NewVirtualizationInterfacesPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := virtualization.NewVirtualizationInterfacesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err = api.Virtualization.VirtualizationInterfacesPartialUpdate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	return diags
@@ -228,7 +233,7 @@ func resourceNetboxInterfaceDelete(ctx context.Context, d *schema.ResourceData,
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := virtualization.NewVirtualizationInterfacesDeleteParams().WithID(id)
+	params := virtualization.NewVirtualizationInterfacesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Virtualization.VirtualizationInterfacesDelete(params, nil)
 	if err != nil {
@@ -238,7 +243,7 @@ func resourceNetboxInterfaceDelete(ctx context.Context, d *schema.ResourceData,
 				return nil
 			}
 		}
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
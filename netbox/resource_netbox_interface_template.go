@@ -87,11 +87,11 @@ func resourceNetboxInterfaceTemplateCreate(ctx context.Context, d *schema.Resour
 	if moduleTypeID, ok := d.Get("module_type_id").(int); ok && moduleTypeID != 0 {
 		data.ModuleType = int64ToPtr(int64(moduleTypeID))
 	}
-	params := dcim.NewDcimInterfaceTemplatesCreateParams().WithData(&data)
+	params := dcim.NewDcimInterfaceTemplatesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimInterfaceTemplatesCreate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
@@ -105,7 +105,7 @@ func resourceNetboxInterfaceTemplateRead(ctx context.Context, d *schema.Resource
 
 	var diags diag.Diagnostics
 
-	params := dcim.NewDcimInterfaceTemplatesReadParams().WithID(id)
+	params := dcim.NewDcimInterfaceTemplatesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimInterfaceTemplatesRead(params, nil)
 	if err != nil {
@@ -169,10 +169,10 @@ func resourceNetboxInterfaceTemplateUpdate(ctx context.Context, d *schema.Resour
 		data.ModuleType = &moduleTypeID
 	}
 
-	params := dcim.NewDcimInterfaceTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimInterfaceTemplatesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err := api.Dcim.DcimInterfaceTemplatesPartialUpdate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	return diags
@@ -182,7 +182,7 @@ func resourceNetboxInterfaceTemplateDelete(ctx context.Context, d *schema.Resour
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimInterfaceTemplatesDeleteParams().WithID(id)
+	params := dcim.NewDcimInterfaceTemplatesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimInterfaceTemplatesDelete(params, nil)
 	if err != nil {
@@ -192,7 +192,7 @@ func resourceNetboxInterfaceTemplateDelete(ctx context.Context, d *schema.Resour
 				return nil
 			}
 		}
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
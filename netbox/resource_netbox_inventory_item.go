@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxInventoryItem() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxInventoryItemCreate,
-		Read:   resourceNetboxInventoryItemRead,
-		Update: resourceNetboxInventoryItemUpdate,
-		Delete: resourceNetboxInventoryItemDelete,
+		CreateContext: resourceNetboxInventoryItemCreate,
+		ReadContext:   resourceNetboxInventoryItemRead,
+		UpdateContext: resourceNetboxInventoryItemUpdate,
+		DeleteContext: resourceNetboxInventoryItemDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/inventoryitem/):
 
@@ -84,8 +86,9 @@ func resourceNetboxInventoryItem() *schema.Resource {
 				Optional:     true,
 				RequiredWith: []string{"component_type"},
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -93,7 +96,7 @@ func resourceNetboxInventoryItem() *schema.Resource {
 	}
 }
 
-func resourceNetboxInventoryItemCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxInventoryItemCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	data := models.WritableInventoryItem{
 		Device:       int64ToPtr(int64(d.Get("device_id").(int))),
@@ -118,32 +121,29 @@ func resourceNetboxInventoryItemCreate(d *schema.ResourceData, m interface{}) er
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimInventoryItemsCreateParams().WithData(&data)
+	params := dcim.NewDcimInventoryItemsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimInventoryItemsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxInventoryItemRead(d, m)
+	return resourceNetboxInventoryItemRead(ctx, d, m)
 }
 
-func resourceNetboxInventoryItemRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxInventoryItemRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimInventoryItemsReadParams().WithID(id)
+	params := dcim.NewDcimInventoryItemsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimInventoryItemsRead(params, nil)
 
@@ -154,7 +154,7 @@ func resourceNetboxInventoryItemRead(d *schema.ResourceData, m interface{}) erro
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	item := res.GetPayload()
@@ -191,16 +191,13 @@ func resourceNetboxInventoryItemRead(d *schema.ResourceData, m interface{}) erro
 	d.Set("component_type", item.ComponentType)
 	d.Set("component_id", item.ComponentID)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxInventoryItemUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxInventoryItemUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -228,35 +225,32 @@ func resourceNetboxInventoryItemUpdate(d *schema.ResourceData, m interface{}) er
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimInventoryItemsPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimInventoryItemsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimInventoryItemsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxInventoryItemRead(d, m)
+	return resourceNetboxInventoryItemRead(ctx, d, m)
 }
 
-func resourceNetboxInventoryItemDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxInventoryItemDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimInventoryItemsDeleteParams().WithID(id)
+	params := dcim.NewDcimInventoryItemsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimInventoryItemsDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
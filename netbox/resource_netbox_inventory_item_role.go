@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxInventoryItemRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxInventoryItemRoleCreate,
-		Read:   resourceNetboxInventoryItemRoleRead,
-		Update: resourceNetboxInventoryItemRoleUpdate,
-		Delete: resourceNetboxInventoryItemRoleDelete,
+		CreateContext: resourceNetboxInventoryItemRoleCreate,
+		ReadContext:   resourceNetboxInventoryItemRoleRead,
+		UpdateContext: resourceNetboxInventoryItemRoleUpdate,
+		DeleteContext: resourceNetboxInventoryItemRoleDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/inventoryitemrole/):
 
@@ -38,8 +40,9 @@ func resourceNetboxInventoryItemRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -47,7 +50,7 @@ func resourceNetboxInventoryItemRole() *schema.Resource {
 	}
 }
 
-func resourceNetboxInventoryItemRoleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxInventoryItemRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	data := models.InventoryItemRole{
 		Name:        strToPtr(d.Get("name").(string)),
@@ -57,32 +60,29 @@ func resourceNetboxInventoryItemRoleCreate(d *schema.ResourceData, m interface{}
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimInventoryItemRolesCreateParams().WithData(&data)
+	params := dcim.NewDcimInventoryItemRolesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimInventoryItemRolesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxInventoryItemRoleRead(d, m)
+	return resourceNetboxInventoryItemRoleRead(ctx, d, m)
 }
 
-func resourceNetboxInventoryItemRoleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxInventoryItemRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimInventoryItemRolesReadParams().WithID(id)
+	params := dcim.NewDcimInventoryItemRolesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimInventoryItemRolesRead(params, nil)
 
@@ -93,7 +93,7 @@ func resourceNetboxInventoryItemRoleRead(d *schema.ResourceData, m interface{})
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	role := res.GetPayload()
@@ -102,16 +102,13 @@ func resourceNetboxInventoryItemRoleRead(d *schema.ResourceData, m interface{})
 	d.Set("color_hex", role.Color)
 	d.Set("description", role.Description)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxInventoryItemRoleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxInventoryItemRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -124,35 +121,32 @@ func resourceNetboxInventoryItemRoleUpdate(d *schema.ResourceData, m interface{}
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimInventoryItemRolesPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimInventoryItemRolesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimInventoryItemRolesPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxInventoryItemRoleRead(d, m)
+	return resourceNetboxInventoryItemRoleRead(ctx, d, m)
 }
 
-func resourceNetboxInventoryItemRoleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxInventoryItemRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimInventoryItemRolesDeleteParams().WithID(id)
+	params := dcim.NewDcimInventoryItemRolesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimInventoryItemRolesDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
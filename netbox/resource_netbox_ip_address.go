@@ -1,10 +1,12 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -15,10 +17,10 @@ var resourceNetboxIPAddressRoleOptions = []string{"loopback", "secondary", "anyc
 
 func resourceNetboxIPAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxIPAddressCreate,
-		Read:   resourceNetboxIPAddressRead,
-		Update: resourceNetboxIPAddressUpdate,
-		Delete: resourceNetboxIPAddressDelete,
+		CreateContext: resourceNetboxIPAddressCreate,
+		ReadContext:   resourceNetboxIPAddressRead,
+		UpdateContext: resourceNetboxIPAddressUpdate,
+		DeleteContext: resourceNetboxIPAddressDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/features/ipam/#ip-addresses):
 
@@ -47,12 +49,18 @@ func resourceNetboxIPAddress() *schema.Resource {
 			"virtual_machine_interface_id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"interface_id", "device_interface_id"},
+				ConflictsWith: []string{"interface_id", "device_interface_id", "fhrp_group_id"},
 			},
 			"device_interface_id": {
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ConflictsWith: []string{"interface_id", "virtual_machine_interface_id"},
+				ConflictsWith: []string{"interface_id", "virtual_machine_interface_id", "fhrp_group_id"},
+			},
+			"fhrp_group_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"interface_id", "virtual_machine_interface_id", "device_interface_id"},
+				Description:   "The ID of the `netbox_fhrp_group` to assign the IP address to as its virtual address.",
 			},
 			"vrf_id": {
 				Type:     schema.TypeInt,
@@ -107,7 +115,8 @@ func resourceNetboxIPAddress() *schema.Resource {
 					},
 				},
 			},
-			customFieldsKey: customFieldsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -115,7 +124,7 @@ func resourceNetboxIPAddress() *schema.Resource {
 	}
 }
 
-func resourceNetboxIPAddressCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIPAddressCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableIPAddress{}
@@ -133,6 +142,7 @@ func resourceNetboxIPAddressCreate(d *schema.ResourceData, m interface{}) error
 	vmInterfaceID := getOptionalInt(d, "virtual_machine_interface_id")
 	deviceInterfaceID := getOptionalInt(d, "device_interface_id")
 	interfaceID := getOptionalInt(d, "interface_id")
+	fhrpGroupID := getOptionalInt(d, "fhrp_group_id")
 
 	switch {
 	case vmInterfaceID != nil:
@@ -141,6 +151,9 @@ func resourceNetboxIPAddressCreate(d *schema.ResourceData, m interface{}) error
 	case deviceInterfaceID != nil:
 		data.AssignedObjectType = strToPtr("dcim.interface")
 		data.AssignedObjectID = deviceInterfaceID
+	case fhrpGroupID != nil:
+		data.AssignedObjectType = strToPtr("ipam.fhrpgroup")
+		data.AssignedObjectID = fhrpGroupID
 	// if interfaceID is given, object_type must be set as well
 	case interfaceID != nil:
 		data.AssignedObjectType = strToPtr(d.Get("object_type").(string))
@@ -152,33 +165,30 @@ func resourceNetboxIPAddressCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := ipam.NewIpamIPAddressesCreateParams().WithData(&data)
+	params := ipam.NewIpamIPAddressesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Ipam.IpamIPAddressesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxIPAddressRead(d, m)
+	return resourceNetboxIPAddressRead(ctx, d, m)
 }
 
-func resourceNetboxIPAddressRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIPAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamIPAddressesReadParams().WithID(id)
+	params := ipam.NewIpamIPAddressesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Ipam.IpamIPAddressesRead(params, nil)
 	if err != nil {
@@ -190,10 +200,16 @@ func resourceNetboxIPAddressRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	ipAddress := res.GetPayload()
+	if ipAddress.AssignedObjectType != nil && *ipAddress.AssignedObjectType == "ipam.fhrpgroup" {
+		d.Set("fhrp_group_id", ipAddress.AssignedObjectID)
+	} else {
+		d.Set("fhrp_group_id", nil)
+	}
+
 	if ipAddress.AssignedObjectID != nil {
 		vmInterfaceID := getOptionalInt(d, "virtual_machine_interface_id")
 		deviceInterfaceID := getOptionalInt(d, "device_interface_id")
@@ -264,14 +280,11 @@ func resourceNetboxIPAddressRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", ipAddress.Description)
 	d.Set("status", ipAddress.Status.Value)
 	api.readTags(d, ipAddress.Tags)
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	return nil
 }
 
-func resourceNetboxIPAddressUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIPAddressUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -290,6 +303,7 @@ func resourceNetboxIPAddressUpdate(d *schema.ResourceData, m interface{}) error
 	vmInterfaceID := getOptionalInt(d, "virtual_machine_interface_id")
 	deviceInterfaceID := getOptionalInt(d, "device_interface_id")
 	interfaceID := getOptionalInt(d, "interface_id")
+	fhrpGroupID := getOptionalInt(d, "fhrp_group_id")
 
 	switch {
 	case vmInterfaceID != nil:
@@ -298,6 +312,9 @@ func resourceNetboxIPAddressUpdate(d *schema.ResourceData, m interface{}) error
 	case deviceInterfaceID != nil:
 		data.AssignedObjectType = strToPtr("dcim.interface")
 		data.AssignedObjectID = deviceInterfaceID
+	case fhrpGroupID != nil:
+		data.AssignedObjectType = strToPtr("ipam.fhrpgroup")
+		data.AssignedObjectID = fhrpGroupID
 	// if interfaceID is given, object_type must be set as well
 	case interfaceID != nil:
 		data.AssignedObjectType = strToPtr(d.Get("object_type").(string))
@@ -309,30 +326,28 @@ func resourceNetboxIPAddressUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	if cf, ok := d.GetOk(customFieldsKey); ok {
-		data.CustomFields = cf
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := ipam.NewIpamIPAddressesUpdateParams().WithID(id).WithData(&data)
+	params := ipam.NewIpamIPAddressesUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Ipam.IpamIPAddressesUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxIPAddressRead(d, m)
+	return resourceNetboxIPAddressRead(ctx, d, m)
 }
 
-func resourceNetboxIPAddressDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIPAddressDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamIPAddressesDeleteParams().WithID(id)
+	params := ipam.NewIpamIPAddressesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Ipam.IpamIPAddressesDelete(params, nil)
 	if err != nil {
@@ -342,7 +357,7 @@ func resourceNetboxIPAddressDelete(d *schema.ResourceData, m interface{}) error
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
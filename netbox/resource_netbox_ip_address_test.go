@@ -480,6 +480,59 @@ resource "netbox_ip_address" "inside" {
 	})
 }
 
+func TestAccNetboxIPAddress_fhrpGroup(t *testing.T) {
+	testSlug := "ipadr_fhrp"
+	testName := testAccGetTestName(testSlug)
+	testIP := "1.1.1.12/32"
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_fhrp_group" "test" {
+  name     = "%s"
+  protocol = "vrrp3"
+  group_id = 12
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address    = "%s"
+  status        = "active"
+  role          = "vrrp"
+  fhrp_group_id = netbox_fhrp_group.test.id
+}`, testName, testIP),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ip_address.test", "role", "vrrp"),
+					resource.TestCheckResourceAttrPair("netbox_ip_address.test", "fhrp_group_id", "netbox_fhrp_group.test", "id"),
+					resource.TestCheckResourceAttr("netbox_ip_address.test", "interface_id", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ip_address.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_fhrp_group" "test" {
+  name     = "%s"
+  protocol = "vrrp3"
+  group_id = 12
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address = "%s"
+  status     = "active"
+}`, testName, testIP),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ip_address.test", "fhrp_group_id", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetboxIPAddress_invalidConfig(t *testing.T) {
 	testIP := "1.1.1.7/32"
 	resource.ParallelTest(t, resource.TestCase{
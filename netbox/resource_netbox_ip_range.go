@@ -1,10 +1,12 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -13,10 +15,10 @@ var resourceNetboxIPRangeStatusOptions = []string{"active", "reserved", "depreca
 
 func resourceNetboxIPRange() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxIPRangeCreate,
-		Read:   resourceNetboxIPRangeRead,
-		Update: resourceNetboxIPRangeUpdate,
-		Delete: resourceNetboxIPRangeDelete,
+		CreateContext: resourceNetboxIPRangeCreate,
+		ReadContext:   resourceNetboxIPRangeRead,
+		UpdateContext: resourceNetboxIPRangeUpdate,
+		DeleteContext: resourceNetboxIPRangeDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/features/ipam/#ip-ranges):
 
@@ -54,7 +56,9 @@ func resourceNetboxIPRange() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -62,7 +66,7 @@ func resourceNetboxIPRange() *schema.Resource {
 	}
 }
 
-func resourceNetboxIPRangeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIPRangeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	data := models.WritableIPRange{}
 
@@ -77,25 +81,26 @@ func resourceNetboxIPRangeCreate(d *schema.ResourceData, m interface{}) error {
 	data.Description = description
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	params := ipam.NewIpamIPRangesCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamIPRangesCreateParams().WithContext(ctx).WithData(&data)
 	res, err := api.Ipam.IpamIPRangesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxIPRangeUpdate(d, m)
+	return resourceNetboxIPRangeUpdate(ctx, d, m)
 }
 
-func resourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIPRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamIPRangesReadParams().WithID(id)
+	params := ipam.NewIpamIPRangesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Ipam.IpamIPRangesRead(params, nil)
 	if err != nil {
@@ -107,7 +112,7 @@ func resourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	if res.GetPayload().StartAddress != nil {
@@ -139,11 +144,12 @@ func resourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	api.readTags(d, res.GetPayload().Tags)
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 
 	return nil
 }
 
-func resourceNetboxIPRangeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIPRangeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableIPRange{}
@@ -171,23 +177,24 @@ func resourceNetboxIPRangeUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	params := ipam.NewIpamIPRangesUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamIPRangesUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err = api.Ipam.IpamIPRangesUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
-	return resourceNetboxIPRangeRead(d, m)
+	return resourceNetboxIPRangeRead(ctx, d, m)
 }
 
-func resourceNetboxIPRangeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIPRangeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamIPRangesDeleteParams().WithID(id)
+	params := ipam.NewIpamIPRangesDeleteParams().WithContext(ctx).WithID(id)
 	_, err := api.Ipam.IpamIPRangesDelete(params, nil)
 	if err != nil {
 		if errresp, ok := err.(*ipam.IpamIPRangesDeleteDefault); ok {
@@ -196,7 +203,7 @@ func resourceNetboxIPRangeDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	return nil
@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxIpamRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxIpamRoleCreate,
-		Read:   resourceNetboxIpamRoleRead,
-		Update: resourceNetboxIpamRoleUpdate,
-		Delete: resourceNetboxIpamRoleDelete,
+		CreateContext: resourceNetboxIpamRoleCreate,
+		ReadContext:   resourceNetboxIpamRoleRead,
+		UpdateContext: resourceNetboxIpamRoleUpdate,
+		DeleteContext: resourceNetboxIpamRoleDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/features/ipam/#prefixvlan-roles):
 
@@ -40,13 +42,15 @@ func resourceNetboxIpamRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
-func resourceNetboxIpamRoleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIpamRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	data := models.Role{}
 
@@ -69,20 +73,21 @@ func resourceNetboxIpamRoleCreate(d *schema.ResourceData, m interface{}) error {
 	data.Description = description
 	data.Tags = []*models.NestedTag{}
 
-	params := ipam.NewIpamRolesCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamRolesCreateParams().WithContext(ctx).WithData(&data)
 	res, err := api.Ipam.IpamRolesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxIpamRoleUpdate(d, m)
+	return resourceNetboxIpamRoleUpdate(ctx, d, m)
 }
 
-func resourceNetboxIpamRoleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIpamRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamRolesReadParams().WithID(id)
+	params := ipam.NewIpamRolesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Ipam.IpamRolesRead(params, nil)
 	if err != nil {
@@ -94,7 +99,7 @@ func resourceNetboxIpamRoleRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	if res.GetPayload().Name != nil {
@@ -112,11 +117,12 @@ func resourceNetboxIpamRoleRead(d *schema.ResourceData, m interface{}) error {
 	if res.GetPayload().Description != "" {
 		d.Set("description", res.GetPayload().Description)
 	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 
 	return nil
 }
 
-func resourceNetboxIpamRoleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIpamRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.Role{}
@@ -140,18 +146,19 @@ func resourceNetboxIpamRoleUpdate(d *schema.ResourceData, m interface{}) error {
 	data.Description = description
 	data.Tags = []*models.NestedTag{}
 
-	params := ipam.NewIpamRolesUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamRolesUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err := api.Ipam.IpamRolesUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
-	return resourceNetboxIpamRoleRead(d, m)
+	return resourceNetboxIpamRoleRead(ctx, d, m)
 }
 
-func resourceNetboxIpamRoleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxIpamRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamRolesDeleteParams().WithID(id)
+	params := ipam.NewIpamRolesDeleteParams().WithContext(ctx).WithID(id)
 	_, err := api.Ipam.IpamRolesDelete(params, nil)
 	if err != nil {
 		if errresp, ok := err.(*ipam.IpamRolesDeleteDefault); ok {
@@ -160,7 +167,7 @@ func resourceNetboxIpamRoleDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId("")
 	return nil
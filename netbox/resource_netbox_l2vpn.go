@@ -0,0 +1,236 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxL2VPNTypeOptions = []string{"vpws", "vpls", "vxlan", "vxlan-evpn", "mpls-evpn", "pbb-evpn", "epl", "evpl", "ep-lan", "evp-lan", "ep-tree", "evp-tree"}
+
+// The generated client only knows the L2VPN endpoints below /ipam/, which
+// Netbox moved to /vpn/, so L2VPNs are managed through the REST API directly.
+const l2vpnsPath = "/vpn/l2vpns/"
+
+// netboxL2VPN is a L2VPN as returned by the Netbox API.
+type netboxL2VPN struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Slug       string `json:"slug"`
+	Identifier *int64 `json:"identifier"`
+	Type       *struct {
+		Value string `json:"value"`
+	} `json:"type"`
+	ImportTargets []*models.NestedRouteTarget `json:"import_targets"`
+	ExportTargets []*models.NestedRouteTarget `json:"export_targets"`
+	Tenant        *models.NestedTenant        `json:"tenant"`
+	Description   string                      `json:"description"`
+	Comments      string                      `json:"comments"`
+	Tags          []*models.NestedTag         `json:"tags"`
+	CustomFields  interface{}                 `json:"custom_fields"`
+}
+
+func l2vpnPath(id int64) string {
+	return fmt.Sprintf("%s%d/", l2vpnsPath, id)
+}
+
+func resourceNetboxL2VPN() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxL2VPNCreate,
+		ReadContext:   resourceNetboxL2VPNRead,
+		UpdateContext: resourceNetboxL2VPNUpdate,
+		DeleteContext: resourceNetboxL2VPNDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/l2vpn/):
+
+> A L2VPN object in NetBox is a representation of a layer 2 bridge technology such as VXLAN, VPLS, or EPL. Each L2VPN can be identified by name as well as by an optional unique identifier (VNI would be an example). Once created, L2VPNs can be terminated to interfaces and VLANs.
+
+Use ` + "`netbox_l2vpn_termination`" + ` to terminate a L2VPN to a VLAN or interface.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxL2VPNTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxL2VPNTypeOptions),
+			},
+			"identifier": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "A numeric identifier of the L2VPN, e.g. the VNI of a VXLAN.",
+			},
+			"import_target_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the `netbox_route_target`s to import.",
+			},
+			"export_target_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the `netbox_route_target`s to export.",
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxL2VPNData(ctx context.Context, api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	slug := getSlug(name)
+	if slugValue, ok := d.GetOk("slug"); ok {
+		slug = slugValue.(string)
+	}
+
+	data := map[string]interface{}{
+		"name":           name,
+		"slug":           slug,
+		"type":           d.Get("type").(string),
+		"identifier":     getOptionalInt(d, "identifier"),
+		"import_targets": toInt64List(d.Get("import_target_ids")),
+		"export_targets": toInt64List(d.Get("export_target_ids")),
+		"tenant":         getOptionalInt(d, "tenant_id"),
+		"description":    d.Get("description").(string),
+		"comments":       d.Get("comments").(string),
+		"tags":           tags,
+	}
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+	return data, nil
+}
+
+func resourceNetboxL2VPNCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxL2VPNData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var l2vpn netboxL2VPN
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, l2vpnsPath, nil, data, &l2vpn); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(l2vpn.ID, 10))
+
+	return resourceNetboxL2VPNRead(ctx, d, m)
+}
+
+func resourceNetboxL2VPNRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var l2vpn netboxL2VPN
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, l2vpnPath(id), nil, nil, &l2vpn); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", l2vpn.Name)
+	d.Set("slug", l2vpn.Slug)
+	d.Set("identifier", l2vpn.Identifier)
+	d.Set("description", l2vpn.Description)
+	d.Set("comments", l2vpn.Comments)
+
+	if l2vpn.Type != nil {
+		d.Set("type", l2vpn.Type.Value)
+	}
+
+	d.Set("import_target_ids", getIDsFromNestedRouteTarget(l2vpn.ImportTargets))
+	d.Set("export_target_ids", getIDsFromNestedRouteTarget(l2vpn.ExportTargets))
+
+	if l2vpn.Tenant != nil {
+		d.Set("tenant_id", l2vpn.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	api.readTags(d, l2vpn.Tags)
+	api.readCustomFields(d, l2vpn.CustomFields)
+	return nil
+}
+
+func resourceNetboxL2VPNUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxL2VPNData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPut, l2vpnPath(id), nil, data, nil); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxL2VPNRead(ctx, d, m)
+}
+
+func resourceNetboxL2VPNDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodDelete, l2vpnPath(id), nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
+
+func getIDsFromNestedRouteTarget(nestedRouteTargets []*models.NestedRouteTarget) []int64 {
+	var routeTargets []int64
+	for _, routeTarget := range nestedRouteTargets {
+		routeTargets = append(routeTargets, routeTarget.ID)
+	}
+	return routeTargets
+}
@@ -0,0 +1,162 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxL2VPNTerminationObjectTypeOptions = []string{"ipam.vlan", "dcim.interface", "virtualization.vminterface"}
+
+const l2vpnTerminationsPath = "/vpn/l2vpn-terminations/"
+
+// netboxL2VPNTermination is a L2VPN termination as returned by the Netbox API.
+type netboxL2VPNTermination struct {
+	ID    int64 `json:"id"`
+	L2vpn *struct {
+		ID int64 `json:"id"`
+	} `json:"l2vpn"`
+	AssignedObjectType string              `json:"assigned_object_type"`
+	AssignedObjectID   int64               `json:"assigned_object_id"`
+	Tags               []*models.NestedTag `json:"tags"`
+	CustomFields       interface{}         `json:"custom_fields"`
+}
+
+func l2vpnTerminationPath(id int64) string {
+	return fmt.Sprintf("%s%d/", l2vpnTerminationsPath, id)
+}
+
+func resourceNetboxL2VPNTermination() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxL2VPNTerminationCreate,
+		ReadContext:   resourceNetboxL2VPNTerminationRead,
+		UpdateContext: resourceNetboxL2VPNTerminationUpdate,
+		DeleteContext: resourceNetboxL2VPNTerminationDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/l2vpntermination/):
+
+> A L2VPN termination is the attachment of an L2VPN to an interface or VLAN. Note that the L2VPNs of the following types may have only two terminations assigned to them: VPWS, EPL, EP-LAN, EP-TREE.
+
+The terminated object is referenced by its ` + "`object_type`" + ` and ` + "`object_id`" + `, e.g. ` + "`ipam.vlan`" + ` and the ID of a ` + "`netbox_vlan`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"l2vpn_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxL2VPNTerminationObjectTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxL2VPNTerminationObjectTypeOptions),
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxL2VPNTerminationData(ctx context.Context, api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"l2vpn":                d.Get("l2vpn_id").(int),
+		"assigned_object_type": d.Get("object_type").(string),
+		"assigned_object_id":   d.Get("object_id").(int),
+		"tags":                 tags,
+	}
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+	return data, nil
+}
+
+func resourceNetboxL2VPNTerminationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxL2VPNTerminationData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var termination netboxL2VPNTermination
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, l2vpnTerminationsPath, nil, data, &termination); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(termination.ID, 10))
+
+	return resourceNetboxL2VPNTerminationRead(ctx, d, m)
+}
+
+func resourceNetboxL2VPNTerminationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var termination netboxL2VPNTermination
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, l2vpnTerminationPath(id), nil, nil, &termination); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if termination.L2vpn != nil {
+		d.Set("l2vpn_id", termination.L2vpn.ID)
+	}
+	d.Set("object_type", termination.AssignedObjectType)
+	d.Set("object_id", termination.AssignedObjectID)
+
+	api.readTags(d, termination.Tags)
+	api.readCustomFields(d, termination.CustomFields)
+	return nil
+}
+
+func resourceNetboxL2VPNTerminationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxL2VPNTerminationData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPut, l2vpnTerminationPath(id), nil, data, nil); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxL2VPNTerminationRead(ctx, d, m)
+}
+
+func resourceNetboxL2VPNTerminationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodDelete, l2vpnTerminationPath(id), nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
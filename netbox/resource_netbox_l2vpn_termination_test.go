@@ -0,0 +1,132 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxL2VPNTerminationFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_role" "test" {
+  name      = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name           = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "test" {
+  name      = "eth0"
+  device_id = netbox_device.test.id
+  type      = "1000base-t"
+}
+
+resource "netbox_vlan" "test" {
+  name = "%[1]s"
+  vid  = 2042
+}
+
+resource "netbox_l2vpn" "test" {
+  name = "%[1]s"
+  type = "vxlan"
+}
+`, testName)
+}
+
+func TestAccNetboxL2VPNTermination_basic(t *testing.T) {
+	testSlug := "l2vpn_term"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxL2VPNTerminationFullDependencies(testName) + `
+resource "netbox_l2vpn_termination" "test" {
+  l2vpn_id    = netbox_l2vpn.test.id
+  object_type = "ipam.vlan"
+  object_id   = netbox_vlan.test.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_l2vpn_termination.test", "l2vpn_id", "netbox_l2vpn.test", "id"),
+					resource.TestCheckResourceAttr("netbox_l2vpn_termination.test", "object_type", "ipam.vlan"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn_termination.test", "object_id", "netbox_vlan.test", "id"),
+				),
+			},
+			{
+				Config: testAccNetboxL2VPNTerminationFullDependencies(testName) + `
+resource "netbox_l2vpn_termination" "test" {
+  l2vpn_id    = netbox_l2vpn.test.id
+  object_type = "dcim.interface"
+  object_id   = netbox_device_interface.test.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_l2vpn_termination.test", "object_type", "dcim.interface"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn_termination.test", "object_id", "netbox_device_interface.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_l2vpn_termination.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_l2vpn_termination", &resource.Sweeper{
+		Name:         "netbox_l2vpn_termination",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*providerState)
+			var res struct {
+				Results []struct {
+					ID    int64 `json:"id"`
+					L2vpn *struct {
+						Name string `json:"name"`
+					} `json:"l2vpn"`
+				} `json:"results"`
+			}
+			if err := netboxAPIRequest(context.Background(), api.Transport, http.MethodGet, l2vpnTerminationsPath, nil, nil, &res); err != nil {
+				return err
+			}
+			for _, termination := range res.Results {
+				if termination.L2vpn != nil && strings.HasPrefix(termination.L2vpn.Name, testPrefix) {
+					if err := netboxAPIRequest(context.Background(), api.Transport, http.MethodDelete, l2vpnTerminationPath(termination.ID), nil, nil, nil); err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a l2vpn termination")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,99 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxL2VPNFullDependencies(testName, rtName string) string {
+	return fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_route_target" "import" {
+  name = "%[2]s-i"
+}
+
+resource "netbox_route_target" "export" {
+  name = "%[2]s-e"
+}
+`, testName, rtName)
+}
+
+func TestAccNetboxL2VPN_basic(t *testing.T) {
+	testSlug := "l2vpn"
+	testName := testAccGetTestName(testSlug)
+	rtName := testAccGetTestName("rt")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxL2VPNFullDependencies(testName, rtName) + fmt.Sprintf(`
+resource "netbox_l2vpn" "test" {
+  name              = "%[1]s"
+  type              = "vxlan-evpn"
+  identifier        = 10042
+  import_target_ids = [netbox_route_target.import.id]
+  export_target_ids = [netbox_route_target.export.id]
+  tenant_id         = netbox_tenant.test.id
+  description       = "test description"
+  comments          = "test comments"
+  tags              = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "type", "vxlan-evpn"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "identifier", "10042"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "import_target_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn.test", "import_target_ids.0", "netbox_route_target.import", "id"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "export_target_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn.test", "export_target_ids.0", "netbox_route_target.export", "id"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn.test", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "description", "test description"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "comments", "test comments"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "tags.0", testName),
+				),
+			},
+			{
+				Config: testAccNetboxL2VPNFullDependencies(testName, rtName) + fmt.Sprintf(`
+resource "netbox_l2vpn" "test" {
+  name = "%[1]s"
+  slug = "%[1]s"
+  type = "vpls"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "slug", testName),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "type", "vpls"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "import_target_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "export_target_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_l2vpn.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_l2vpn", &resource.Sweeper{
+		Name:         "netbox_l2vpn",
+		Dependencies: []string{"netbox_l2vpn_termination"},
+		F: func(region string) error {
+			return testAccSweepObjectsByName(region, l2vpnsPath)
+		},
+	})
+}
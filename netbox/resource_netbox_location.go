@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxLocation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxLocationCreate,
-		Read:   resourceNetboxLocationRead,
-		Update: resourceNetboxLocationUpdate,
-		Delete: resourceNetboxLocationDelete,
+		CreateContext: resourceNetboxLocationCreate,
+		ReadContext:   resourceNetboxLocationRead,
+		UpdateContext: resourceNetboxLocationUpdate,
+		DeleteContext: resourceNetboxLocationDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/sites-and-racks/#locations):
 
@@ -55,7 +57,8 @@ Each location must have a name that is unique within its parent site and locatio
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			customFieldsKey: customFieldsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -63,7 +66,7 @@ Each location must have a name that is unique within its parent site and locatio
 	}
 }
 
-func resourceNetboxLocationCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxLocationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableLocation{}
@@ -98,32 +101,29 @@ func resourceNetboxLocationCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimLocationsCreateParams().WithData(&data)
+	params := dcim.NewDcimLocationsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimLocationsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxLocationRead(d, m)
+	return resourceNetboxLocationRead(ctx, d, m)
 }
 
-func resourceNetboxLocationRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxLocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimLocationsReadParams().WithID(id)
+	params := dcim.NewDcimLocationsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimLocationsRead(params, nil)
 
@@ -136,7 +136,7 @@ func resourceNetboxLocationRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	location := res.GetPayload()
@@ -164,16 +164,13 @@ func resourceNetboxLocationRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("tenant_id", nil)
 	}
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxLocationUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxLocationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -212,31 +209,28 @@ func resourceNetboxLocationUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimLocationsPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimLocationsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimLocationsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxLocationRead(d, m)
+	return resourceNetboxLocationRead(ctx, d, m)
 }
 
-func resourceNetboxLocationDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxLocationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimLocationsDeleteParams().WithID(id)
+	params := dcim.NewDcimLocationsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimLocationsDelete(params, nil)
 	if err != nil {
@@ -246,7 +240,7 @@ func resourceNetboxLocationDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
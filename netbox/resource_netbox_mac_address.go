@@ -1,11 +1,13 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -14,10 +16,10 @@ var resourceNetboxMACAddressObjectTypeOptions = []string{"virtualization.vminter
 
 func resourceNetboxMACAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxMACAddressCreate,
-		Read:   resourceNetboxMACAddressRead,
-		Update: resourceNetboxMACAddressUpdate,
-		Delete: resourceNetboxMACAddressDelete,
+		CreateContext: resourceNetboxMACAddressCreate,
+		ReadContext:   resourceNetboxMACAddressRead,
+		UpdateContext: resourceNetboxMACAddressUpdate,
+		DeleteContext: resourceNetboxMACAddressDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://netboxlabs.com/docs/netbox/models/dcim/macaddress/):
 
@@ -62,8 +64,9 @@ func resourceNetboxMACAddress() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -71,7 +74,7 @@ func resourceNetboxMACAddress() *schema.Resource {
 	}
 }
 
-func resourceNetboxMACAddressCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxMACAddressCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.MACAddress{}
@@ -103,32 +106,29 @@ func resourceNetboxMACAddressCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimMacAddressesCreateParams().WithData(&data)
+	params := dcim.NewDcimMacAddressesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimMacAddressesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxMACAddressRead(d, m)
+	return resourceNetboxMACAddressRead(ctx, d, m)
 }
 
-func resourceNetboxMACAddressRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxMACAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimMacAddressesReadParams().WithID(id)
+	params := dcim.NewDcimMacAddressesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimMacAddressesRead(params, nil)
 
@@ -141,7 +141,7 @@ func resourceNetboxMACAddressRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	macAddress := res.GetPayload()
@@ -171,15 +171,12 @@ func resourceNetboxMACAddressRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("comments", macAddress.Comments)
 	api.readTags(d, macAddress.Tags)
 
-	cf := getCustomFields(macAddress.CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, macAddress.CustomFields)
 
 	return nil
 }
 
-func resourceNetboxMACAddressUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxMACAddressUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -213,31 +210,28 @@ func resourceNetboxMACAddressUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimMacAddressesPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimMacAddressesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimMacAddressesPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxMACAddressRead(d, m)
+	return resourceNetboxMACAddressRead(ctx, d, m)
 }
 
-func resourceNetboxMACAddressDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxMACAddressDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimMacAddressesDeleteParams().WithID(id)
+	params := dcim.NewDcimMacAddressesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimMacAddressesDelete(params, nil)
 	if err != nil {
@@ -247,7 +241,7 @@ func resourceNetboxMACAddressDelete(d *schema.ResourceData, m interface{}) error
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
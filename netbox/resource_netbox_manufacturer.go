@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxManufacturer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxManufacturerCreate,
-		Read:   resourceNetboxManufacturerRead,
-		Update: resourceNetboxManufacturerUpdate,
-		Delete: resourceNetboxManufacturerDelete,
+		CreateContext: resourceNetboxManufacturerCreate,
+		ReadContext:   resourceNetboxManufacturerRead,
+		UpdateContext: resourceNetboxManufacturerUpdate,
+		DeleteContext: resourceNetboxManufacturerDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/device-types/#manufacturers):
 
@@ -31,6 +33,8 @@ func resourceNetboxManufacturer() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -38,7 +42,7 @@ func resourceNetboxManufacturer() *schema.Resource {
 	}
 }
 
-func resourceNetboxManufacturerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxManufacturerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.Manufacturer{}
@@ -56,22 +60,23 @@ func resourceNetboxManufacturerCreate(d *schema.ResourceData, m interface{}) err
 
 	data.Tags = []*models.NestedTag{}
 
-	params := dcim.NewDcimManufacturersCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := dcim.NewDcimManufacturersCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimManufacturersCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxManufacturerRead(d, m)
+	return resourceNetboxManufacturerRead(ctx, d, m)
 }
 
-func resourceNetboxManufacturerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxManufacturerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimManufacturersReadParams().WithID(id)
+	params := dcim.NewDcimManufacturersReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimManufacturersRead(params, nil)
 
@@ -84,16 +89,17 @@ func resourceNetboxManufacturerRead(d *schema.ResourceData, m interface{}) error
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", res.GetPayload().Name)
 	d.Set("slug", res.GetPayload().Slug)
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 
 	return nil
 }
 
-func resourceNetboxManufacturerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxManufacturerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -112,21 +118,22 @@ func resourceNetboxManufacturerUpdate(d *schema.ResourceData, m interface{}) err
 
 	data.Tags = []*models.NestedTag{}
 
-	params := dcim.NewDcimManufacturersPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := dcim.NewDcimManufacturersPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Dcim.DcimManufacturersPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxManufacturerRead(d, m)
+	return resourceNetboxManufacturerRead(ctx, d, m)
 }
 
-func resourceNetboxManufacturerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxManufacturerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimManufacturersDeleteParams().WithID(id)
+	params := dcim.NewDcimManufacturersDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimManufacturersDelete(params, nil)
 	if err != nil {
@@ -136,7 +143,7 @@ func resourceNetboxManufacturerDelete(d *schema.ResourceData, m interface{}) err
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
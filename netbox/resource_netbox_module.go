@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxModule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxModuleCreate,
-		Read:   resourceNetboxModuleRead,
-		Update: resourceNetboxModuleUpdate,
-		Delete: resourceNetboxModuleDelete,
+		CreateContext: resourceNetboxModuleCreate,
+		ReadContext:   resourceNetboxModuleRead,
+		UpdateContext: resourceNetboxModuleUpdate,
+		DeleteContext: resourceNetboxModuleDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/module/):
 
@@ -57,8 +59,9 @@ Similar to devices, modules are instantiated from module types, and any componen
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -66,7 +69,7 @@ Similar to devices, modules are instantiated from module types, and any componen
 	}
 }
 
-func resourceNetboxModuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxModuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableModule{
@@ -84,32 +87,29 @@ func resourceNetboxModuleCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimModulesCreateParams().WithData(&data)
+	params := dcim.NewDcimModulesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimModulesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxModuleRead(d, m)
+	return resourceNetboxModuleRead(ctx, d, m)
 }
 
-func resourceNetboxModuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxModuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimModulesReadParams().WithID(id)
+	params := dcim.NewDcimModulesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimModulesRead(params, nil)
 
@@ -120,7 +120,7 @@ func resourceNetboxModuleRead(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	module := res.GetPayload()
@@ -154,16 +154,13 @@ func resourceNetboxModuleRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", module.Description)
 	d.Set("comments", module.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxModuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxModuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -183,35 +180,32 @@ func resourceNetboxModuleUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimModulesPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimModulesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimModulesPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxModuleRead(d, m)
+	return resourceNetboxModuleRead(ctx, d, m)
 }
 
-func resourceNetboxModuleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxModuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimModulesDeleteParams().WithID(id)
+	params := dcim.NewDcimModulesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimModulesDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
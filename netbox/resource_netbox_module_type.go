@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxModuleType() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxModuleTypeCreate,
-		Read:   resourceNetboxModuleTypeRead,
-		Update: resourceNetboxModuleTypeUpdate,
-		Delete: resourceNetboxModuleTypeDelete,
+		CreateContext: resourceNetboxModuleTypeCreate,
+		ReadContext:   resourceNetboxModuleTypeRead,
+		UpdateContext: resourceNetboxModuleTypeUpdate,
+		DeleteContext: resourceNetboxModuleTypeDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/moduletype/):
 
@@ -52,8 +54,9 @@ func resourceNetboxModuleType() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -61,7 +64,7 @@ func resourceNetboxModuleType() *schema.Resource {
 	}
 }
 
-func resourceNetboxModuleTypeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxModuleTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableModuleType{
@@ -75,32 +78,29 @@ func resourceNetboxModuleTypeCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimModuleTypesCreateParams().WithData(&data)
+	params := dcim.NewDcimModuleTypesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimModuleTypesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxModuleTypeRead(d, m)
+	return resourceNetboxModuleTypeRead(ctx, d, m)
 }
 
-func resourceNetboxModuleTypeRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxModuleTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimModuleTypesReadParams().WithID(id)
+	params := dcim.NewDcimModuleTypesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimModuleTypesRead(params, nil)
 
@@ -111,7 +111,7 @@ func resourceNetboxModuleTypeRead(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	moduleType := res.GetPayload()
@@ -135,16 +135,13 @@ func resourceNetboxModuleTypeRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", moduleType.Description)
 	d.Set("comments", moduleType.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxModuleTypeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxModuleTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -160,35 +157,32 @@ func resourceNetboxModuleTypeUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimModuleTypesPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimModuleTypesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimModuleTypesPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxModuleTypeRead(d, m)
+	return resourceNetboxModuleTypeRead(ctx, d, m)
 }
 
-func resourceNetboxModuleTypeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxModuleTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimModuleTypesDeleteParams().WithID(id)
+	params := dcim.NewDcimModuleTypesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimModuleTypesDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
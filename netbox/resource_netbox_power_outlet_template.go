@@ -90,10 +90,10 @@ func resourcePowerOutletTemplateCreate(ctx context.Context, d *schema.ResourceDa
 	if moduleTypeID, ok := d.Get("module_type_id").(int); ok && moduleTypeID != 0 {
 		data.ModuleType = int64ToPtr(int64(moduleTypeID))
 	}
-	params := dcim.NewDcimPowerOutletTemplatesCreateParams().WithData(&data)
+	params := dcim.NewDcimPowerOutletTemplatesCreateParams().WithContext(ctx).WithData(&data)
 	res, err := api.Dcim.DcimPowerOutletTemplatesCreate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
@@ -107,7 +107,7 @@ func resourcePowerOutletTemplateRead(ctx context.Context, d *schema.ResourceData
 
 	var diags diag.Diagnostics
 
-	params := dcim.NewDcimPowerOutletTemplatesReadParams().WithID(id)
+	params := dcim.NewDcimPowerOutletTemplatesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimPowerOutletTemplatesRead(params, nil)
 	if err != nil {
@@ -188,10 +188,10 @@ func resourcePowerOutletTemplateUpdate(ctx context.Context, d *schema.ResourceDa
 		data.ModuleType = &moduleTypeID
 	}
 
-	params := dcim.NewDcimPowerOutletTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimPowerOutletTemplatesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err := api.Dcim.DcimPowerOutletTemplatesPartialUpdate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	return diags
@@ -201,7 +201,7 @@ func resourcePowerOutletTemplateDelete(ctx context.Context, d *schema.ResourceDa
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimPowerOutletTemplatesDeleteParams().WithID(id)
+	params := dcim.NewDcimPowerOutletTemplatesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimPowerOutletTemplatesDelete(params, nil)
 	if err != nil {
@@ -211,7 +211,7 @@ func resourcePowerOutletTemplateDelete(ctx context.Context, d *schema.ResourceDa
 				return nil
 			}
 		}
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
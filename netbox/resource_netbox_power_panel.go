@@ -1,19 +1,21 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxPowerPanel() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxPowerPanelCreate,
-		Read:   resourceNetboxPowerPanelRead,
-		Update: resourceNetboxPowerPanelUpdate,
-		Delete: resourceNetboxPowerPanelDelete,
+		CreateContext: resourceNetboxPowerPanelCreate,
+		ReadContext:   resourceNetboxPowerPanelRead,
+		UpdateContext: resourceNetboxPowerPanelUpdate,
+		DeleteContext: resourceNetboxPowerPanelDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/powerpanel/):
 
@@ -40,8 +42,9 @@ func resourceNetboxPowerPanel() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -49,7 +52,7 @@ func resourceNetboxPowerPanel() *schema.Resource {
 	}
 }
 
-func resourceNetboxPowerPanelCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxPowerPanelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritablePowerPanel{
@@ -61,32 +64,29 @@ func resourceNetboxPowerPanelCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimPowerPanelsCreateParams().WithData(&data)
+	params := dcim.NewDcimPowerPanelsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimPowerPanelsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxPowerPanelRead(d, m)
+	return resourceNetboxPowerPanelRead(ctx, d, m)
 }
 
-func resourceNetboxPowerPanelRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxPowerPanelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimPowerPanelsReadParams().WithID(id)
+	params := dcim.NewDcimPowerPanelsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimPowerPanelsRead(params, nil)
 
@@ -97,7 +97,7 @@ func resourceNetboxPowerPanelRead(d *schema.ResourceData, m interface{}) error {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	powerPanel := res.GetPayload()
@@ -119,16 +119,13 @@ func resourceNetboxPowerPanelRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", powerPanel.Description)
 	d.Set("comments", powerPanel.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxPowerPanelUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxPowerPanelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -142,35 +139,32 @@ func resourceNetboxPowerPanelUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimPowerPanelsPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimPowerPanelsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimPowerPanelsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxPowerPanelRead(d, m)
+	return resourceNetboxPowerPanelRead(ctx, d, m)
 }
 
-func resourceNetboxPowerPanelDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxPowerPanelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimPowerPanelsDeleteParams().WithID(id)
+	params := dcim.NewDcimPowerPanelsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimPowerPanelsDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -99,10 +99,10 @@ func resourceNetboxPowerPortTemplateCreate(ctx context.Context, d *schema.Resour
 		data.ModuleType = int64ToPtr(int64(moduleTypeID))
 	}
 	//params := dcim.NewDcimInterfaceTemplatesCreateParams().WithData(&data)
-	params := dcim.NewDcimPowerPortTemplatesCreateParams().WithData(&data)
+	params := dcim.NewDcimPowerPortTemplatesCreateParams().WithContext(ctx).WithData(&data)
 	res, err := api.Dcim.DcimPowerPortTemplatesCreate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
@@ -116,7 +116,7 @@ func resourceNetboxPowerPortTemplateRead(ctx context.Context, d *schema.Resource
 
 	var diags diag.Diagnostics
 
-	params := dcim.NewDcimPowerPortTemplatesReadParams().WithID(id)
+	params := dcim.NewDcimPowerPortTemplatesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimPowerPortTemplatesRead(params, nil)
 	if err != nil {
@@ -187,10 +187,10 @@ func resourceNetboxPowerPortTemplateUpdate(ctx context.Context, d *schema.Resour
 		data.ModuleType = &moduleTypeID
 	}
 
-	params := dcim.NewDcimPowerPortTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimPowerPortTemplatesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err := api.Dcim.DcimPowerPortTemplatesPartialUpdate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	return diags
@@ -200,7 +200,7 @@ func resourceNetboxPowerPortTemplateDelete(ctx context.Context, d *schema.Resour
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimPowerPortTemplatesDeleteParams().WithID(id)
+	params := dcim.NewDcimPowerPortTemplatesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimPowerPortTemplatesDelete(params, nil)
 	if err != nil {
@@ -210,7 +210,7 @@ func resourceNetboxPowerPortTemplateDelete(ctx context.Context, d *schema.Resour
 				return nil
 			}
 		}
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,10 +1,12 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,10 +19,10 @@ var resourceNetboxRackFormFactorOptions = []string{"2-post-frame", "4-post-frame
 
 func resourceNetboxRack() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxRackCreate,
-		Read:   resourceNetboxRackRead,
-		Update: resourceNetboxRackUpdate,
-		Delete: resourceNetboxRackDelete,
+		CreateContext: resourceNetboxRackCreate,
+		ReadContext:   resourceNetboxRackRead,
+		UpdateContext: resourceNetboxRackUpdate,
+		DeleteContext: resourceNetboxRackDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/rack/):
 
@@ -138,7 +140,8 @@ Each rack is assigned a name and (optionally) a separate facility ID. This is he
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			customFieldsKey: customFieldsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 			"form_factor": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -152,7 +155,7 @@ Each rack is assigned a name and (optionally) a separate facility ID. This is he
 	}
 }
 
-func resourceNetboxRackCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
@@ -196,32 +199,29 @@ func resourceNetboxRackCreate(d *schema.ResourceData, m interface{}) error {
 	data.FormFactor = getOptionalStr(d, "form_factor", false)
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimRacksCreateParams().WithData(&data)
+	params := dcim.NewDcimRacksCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimRacksCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxRackRead(d, m)
+	return resourceNetboxRackRead(ctx, d, m)
 }
 
-func resourceNetboxRackRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimRacksReadParams().WithID(id)
+	params := dcim.NewDcimRacksReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimRacksRead(params, nil)
 
@@ -234,7 +234,7 @@ func resourceNetboxRackRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	rack := res.GetPayload()
@@ -313,16 +313,13 @@ func resourceNetboxRackRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("form_factor", nil)
 	}
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	api.readTags(d, res.GetPayload().Tags)
 
 	return nil
 }
 
-func resourceNetboxRackUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -370,31 +367,28 @@ func resourceNetboxRackUpdate(d *schema.ResourceData, m interface{}) error {
 	data.FormFactor = getOptionalStr(d, "form_factor", false)
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = cf
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
-	params := dcim.NewDcimRacksPartialUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimRacksPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimRacksPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxRackRead(d, m)
+	return resourceNetboxRackRead(ctx, d, m)
 }
 
-func resourceNetboxRackDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimRacksDeleteParams().WithID(id)
+	params := dcim.NewDcimRacksDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimRacksDelete(params, nil)
 	if err != nil {
@@ -404,7 +398,7 @@ func resourceNetboxRackDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
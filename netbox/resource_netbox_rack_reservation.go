@@ -1,19 +1,21 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNetboxRackReservation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxRackReservationCreate,
-		Read:   resourceNetboxRackReservationRead,
-		Update: resourceNetboxRackReservationUpdate,
-		Delete: resourceNetboxRackReservationDelete,
+		CreateContext: resourceNetboxRackReservationCreate,
+		ReadContext:   resourceNetboxRackReservationRead,
+		UpdateContext: resourceNetboxRackReservationUpdate,
+		DeleteContext: resourceNetboxRackReservationDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/rackreservation/):
 
@@ -48,7 +50,9 @@ func resourceNetboxRackReservation() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -56,37 +60,38 @@ func resourceNetboxRackReservation() *schema.Resource {
 	}
 }
 
-func resourceNetboxRackReservationCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackReservationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 
-	params := dcim.NewDcimRackReservationsCreateParams().WithData(
+	params := dcim.NewDcimRackReservationsCreateParams().WithContext(ctx).WithData(
 		&models.WritableRackReservation{
-			Rack:        getOptionalInt(d, "rack_id"),
-			Units:       toInt64PtrList(d.Get("units")),
-			User:        getOptionalInt(d, "user_id"),
-			Description: strToPtr(getOptionalStr(d, "description", false)),
-			Tenant:      getOptionalInt(d, "tenant_id"),
-			Comments:    getOptionalStr(d, "comments", false),
-			Tags:        tags,
+			Rack:         getOptionalInt(d, "rack_id"),
+			Units:        toInt64PtrList(d.Get("units")),
+			User:         getOptionalInt(d, "user_id"),
+			Description:  strToPtr(getOptionalStr(d, "description", false)),
+			Tenant:       getOptionalInt(d, "tenant_id"),
+			Comments:     getOptionalStr(d, "comments", false),
+			Tags:         tags,
+			CustomFields: api.customFieldsFromResourceData(d),
 		},
 	)
 
 	res, err := api.Dcim.DcimRackReservationsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxRackReservationRead(d, m)
+	return resourceNetboxRackReservationRead(ctx, d, m)
 }
 
-func resourceNetboxRackReservationRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackReservationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimRackReservationsReadParams().WithID(id)
+	params := dcim.NewDcimRackReservationsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimRackReservationsRead(params, nil)
 	if err != nil {
@@ -98,7 +103,7 @@ func resourceNetboxRackReservationRead(d *schema.ResourceData, m interface{}) er
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	rackRes := res.GetPayload()
@@ -126,15 +131,16 @@ func resourceNetboxRackReservationRead(d *schema.ResourceData, m interface{}) er
 	d.Set("comments", rackRes.Comments)
 
 	api.readTags(d, res.GetPayload().Tags)
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	return nil
 }
 
-func resourceNetboxRackReservationUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackReservationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 
 	data := models.WritableRackReservation{
 		Rack:        getOptionalInt(d, "rack_id"),
@@ -146,21 +152,22 @@ func resourceNetboxRackReservationUpdate(d *schema.ResourceData, m interface{})
 		Tags:        tags,
 	}
 
-	params := dcim.NewDcimRackReservationsPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := dcim.NewDcimRackReservationsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Dcim.DcimRackReservationsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxRackReservationRead(d, m)
+	return resourceNetboxRackReservationRead(ctx, d, m)
 }
 
-func resourceNetboxRackReservationDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackReservationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimRackReservationsDeleteParams().WithID(id)
+	params := dcim.NewDcimRackReservationsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimRackReservationsDelete(params, nil)
 	if err != nil {
@@ -170,7 +177,7 @@ func resourceNetboxRackReservationDelete(d *schema.ResourceData, m interface{})
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxRackRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxRackRoleCreate,
-		Read:   resourceNetboxRackRoleRead,
-		Update: resourceNetboxRackRoleUpdate,
-		Delete: resourceNetboxRackRoleDelete,
+		CreateContext: resourceNetboxRackRoleCreate,
+		ReadContext:   resourceNetboxRackRoleRead,
+		UpdateContext: resourceNetboxRackRoleUpdate,
+		DeleteContext: resourceNetboxRackRoleDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/rackrole/):
 
@@ -39,7 +41,9 @@ func resourceNetboxRackRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -47,7 +51,7 @@ func resourceNetboxRackRole() *schema.Resource {
 	}
 }
 
-func resourceNetboxRackRoleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
@@ -64,32 +68,33 @@ func resourceNetboxRackRoleCreate(d *schema.ResourceData, m interface{}) error {
 	color := d.Get("color_hex").(string)
 	description := getOptionalStr(d, "description", false)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 
-	params := dcim.NewDcimRackRolesCreateParams().WithData(
+	params := dcim.NewDcimRackRolesCreateParams().WithContext(ctx).WithData(
 		&models.RackRole{
-			Name:        &name,
-			Slug:        &slug,
-			Color:       color,
-			Description: description,
-			Tags:        tags,
+			Name:         &name,
+			Slug:         &slug,
+			Color:        color,
+			Description:  description,
+			Tags:         tags,
+			CustomFields: api.customFieldsFromResourceData(d),
 		},
 	)
 
 	res, err := api.Dcim.DcimRackRolesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxRackRoleRead(d, m)
+	return resourceNetboxRackRoleRead(ctx, d, m)
 }
 
-func resourceNetboxRackRoleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimRackRolesReadParams().WithID(id)
+	params := dcim.NewDcimRackRolesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimRackRolesRead(params, nil)
 	if err != nil {
@@ -101,7 +106,7 @@ func resourceNetboxRackRoleRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	rackRole := res.GetPayload()
@@ -111,10 +116,11 @@ func resourceNetboxRackRoleRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", rackRole.Description)
 	d.Set("color_hex", rackRole.Color)
 	api.readTags(d, res.GetPayload().Tags)
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	return nil
 }
 
-func resourceNetboxRackRoleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -138,24 +144,25 @@ func resourceNetboxRackRoleUpdate(d *schema.ResourceData, m interface{}) error {
 	data.Description = getOptionalStr(d, "description", true)
 	data.Color = color
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	data.Tags = tags
 
-	params := dcim.NewDcimRackRolesPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := dcim.NewDcimRackRolesPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Dcim.DcimRackRolesPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxRackRoleRead(d, m)
+	return resourceNetboxRackRoleRead(ctx, d, m)
 }
 
-func resourceNetboxRackRoleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimRackRolesDeleteParams().WithID(id)
+	params := dcim.NewDcimRackRolesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimRackRolesDelete(params, nil)
 	if err != nil {
@@ -165,7 +172,7 @@ func resourceNetboxRackRoleDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -1,10 +1,12 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -16,10 +18,10 @@ var resourceNetboxRackTypeWidthOptions = []int{10, 19, 21, 23}
 
 func resourceNetboxRackType() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxRackTypeCreate,
-		Read:   resourceNetboxRackTypeRead,
-		Update: resourceNetboxRackTypeUpdate,
-		Delete: resourceNetboxRackTypeDelete,
+		CreateContext: resourceNetboxRackTypeCreate,
+		ReadContext:   resourceNetboxRackTypeRead,
+		UpdateContext: resourceNetboxRackTypeUpdate,
+		DeleteContext: resourceNetboxRackTypeDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://netboxlabs.com/docs/netbox/en/stable/models/dcim/racktype/):
 
@@ -61,7 +63,9 @@ func resourceNetboxRackType() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -186,7 +190,7 @@ func resourceNetboxRackType() *schema.Resource {
 	}
 }
 
-func resourceNetboxRackTypeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	model := d.Get("model").(string)
@@ -223,27 +227,28 @@ func resourceNetboxRackTypeCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	params := dcim.NewDcimRackTypesCreateParams().WithData(&data)
+	data.CustomFields = getCustomFields(api.customFieldsFromResourceData(d))
+	params := dcim.NewDcimRackTypesCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimRackTypesCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxRackTypeRead(d, m)
+	return resourceNetboxRackTypeRead(ctx, d, m)
 }
 
-func resourceNetboxRackTypeRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimRackTypesReadParams().WithID(id)
+	params := dcim.NewDcimRackTypesReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimRackTypesRead(params, nil)
 
@@ -256,7 +261,7 @@ func resourceNetboxRackTypeRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	rackType := res.GetPayload()
@@ -274,6 +279,7 @@ func resourceNetboxRackTypeRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("u_height", rackType.UHeight)
 	api.readTags(d, res.GetPayload().Tags)
+	api.readCustomFields(d, rackType.CustomFields)
 	d.Set("description", rackType.Description)
 	d.Set("comments", rackType.Comments)
 
@@ -300,7 +306,7 @@ func resourceNetboxRackTypeRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNetboxRackTypeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -336,21 +342,22 @@ func resourceNetboxRackTypeUpdate(d *schema.ResourceData, m interface{}) error {
 		MountingDepth: getOptionalInt(d, "mounting_depth_mm"),
 	}
 
-	params := dcim.NewDcimRackTypesUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = getCustomFields(api.customFieldsFromResourceData(d))
+	params := dcim.NewDcimRackTypesUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Dcim.DcimRackTypesUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxRackTypeRead(d, m)
+	return resourceNetboxRackTypeRead(ctx, d, m)
 }
 
-func resourceNetboxRackTypeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRackTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimRackTypesDeleteParams().WithID(id)
+	params := dcim.NewDcimRackTypesDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimRackTypesDelete(params, nil)
 	if err != nil {
@@ -360,7 +367,7 @@ func resourceNetboxRackTypeDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
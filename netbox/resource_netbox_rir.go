@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxRir() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxRirCreate,
-		Read:   resourceNetboxRirRead,
-		Update: resourceNetboxRirUpdate,
-		Delete: resourceNetboxRirDelete,
+		CreateContext: resourceNetboxRirCreate,
+		ReadContext:   resourceNetboxRirRead,
+		UpdateContext: resourceNetboxRirUpdate,
+		DeleteContext: resourceNetboxRirDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/features/ipam/#regional-internet-registries-rirs):
 
@@ -40,6 +42,8 @@ func resourceNetboxRir() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -47,7 +51,7 @@ func resourceNetboxRir() *schema.Resource {
 	}
 }
 
-func resourceNetboxRirCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRirCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	data := models.RIR{}
 
@@ -67,20 +71,21 @@ func resourceNetboxRirCreate(d *schema.ResourceData, m interface{}) error {
 	data.Tags = []*models.NestedTag{}
 	data.IsPrivate = d.Get("is_private").(bool)
 
-	params := ipam.NewIpamRirsCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamRirsCreateParams().WithContext(ctx).WithData(&data)
 	res, err := api.Ipam.IpamRirsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxRirUpdate(d, m)
+	return resourceNetboxRirUpdate(ctx, d, m)
 }
 
-func resourceNetboxRirRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRirRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamRirsReadParams().WithID(id)
+	params := ipam.NewIpamRirsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Ipam.IpamRirsRead(params, nil)
 	if err != nil {
@@ -92,7 +97,7 @@ func resourceNetboxRirRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	rir := res.GetPayload()
@@ -101,11 +106,12 @@ func resourceNetboxRirRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("slug", rir.Slug)
 	d.Set("description", rir.Description)
 	d.Set("is_private", rir.IsPrivate)
+	api.readCustomFields(d, rir.CustomFields)
 
 	return nil
 }
 
-func resourceNetboxRirUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRirUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.RIR{}
@@ -126,18 +132,19 @@ func resourceNetboxRirUpdate(d *schema.ResourceData, m interface{}) error {
 	data.Tags = []*models.NestedTag{}
 	data.IsPrivate = d.Get("is_private").(bool)
 
-	params := ipam.NewIpamRirsUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamRirsUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err := api.Ipam.IpamRirsUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
-	return resourceNetboxRirRead(d, m)
+	return resourceNetboxRirRead(ctx, d, m)
 }
 
-func resourceNetboxRirDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRirDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamRirsDeleteParams().WithID(id)
+	params := ipam.NewIpamRirsDeleteParams().WithContext(ctx).WithID(id)
 	_, err := api.Ipam.IpamRirsDelete(params, nil)
 	if err != nil {
 		if errresp, ok := err.(*ipam.IpamRirsDeleteDefault); ok {
@@ -146,7 +153,7 @@ func resourceNetboxRirDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId("")
 	return nil
@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxRouteTarget() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxRouteTargetCreate,
-		Read:   resourceNetboxRouteTargetRead,
-		Update: resourceNetboxRouteTargetUpdate,
-		Delete: resourceNetboxRouteTargetDelete,
+		CreateContext: resourceNetboxRouteTargetCreate,
+		ReadContext:   resourceNetboxRouteTargetRead,
+		UpdateContext: resourceNetboxRouteTargetUpdate,
+		DeleteContext: resourceNetboxRouteTargetDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/routetarget/):
 
@@ -35,14 +37,16 @@ func resourceNetboxRouteTarget() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 200),
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
-func resourceNetboxRouteTargetCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRouteTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	data := models.WritableRouteTarget{}
 
@@ -51,20 +55,21 @@ func resourceNetboxRouteTargetCreate(d *schema.ResourceData, m interface{}) erro
 	data.Name = &name
 	data.Tags = []*models.NestedTag{}
 
-	params := ipam.NewIpamRouteTargetsCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamRouteTargetsCreateParams().WithContext(ctx).WithData(&data)
 	res, err := api.Ipam.IpamRouteTargetsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxRouteTargetUpdate(d, m)
+	return resourceNetboxRouteTargetUpdate(ctx, d, m)
 }
 
-func resourceNetboxRouteTargetRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRouteTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamRouteTargetsReadParams().WithID(id)
+	params := ipam.NewIpamRouteTargetsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Ipam.IpamRouteTargetsRead(params, nil)
 	if err != nil {
@@ -76,7 +81,7 @@ func resourceNetboxRouteTargetRead(d *schema.ResourceData, m interface{}) error
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	if res.GetPayload().Name != nil {
@@ -93,12 +98,13 @@ func resourceNetboxRouteTargetRead(d *schema.ResourceData, m interface{}) error
 
 	if res.GetPayload().Tags != nil {
 		api.readTags(d, res.GetPayload().Tags)
+		api.readCustomFields(d, res.GetPayload().CustomFields)
 	}
 
 	return nil
 }
 
-func resourceNetboxRouteTargetUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRouteTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableRouteTarget{}
@@ -112,18 +118,19 @@ func resourceNetboxRouteTargetUpdate(d *schema.ResourceData, m interface{}) erro
 	data.Tenant = &tenantID
 	data.Tags = []*models.NestedTag{}
 
-	params := ipam.NewIpamRouteTargetsUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamRouteTargetsUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err := api.Ipam.IpamRouteTargetsUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
-	return resourceNetboxRouteTargetRead(d, m)
+	return resourceNetboxRouteTargetRead(ctx, d, m)
 }
 
-func resourceNetboxRouteTargetDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxRouteTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamRouteTargetsDeleteParams().WithID(id)
+	params := ipam.NewIpamRouteTargetsDeleteParams().WithContext(ctx).WithID(id)
 	_, err := api.Ipam.IpamRouteTargetsDelete(params, nil)
 	if err != nil {
 		if errresp, ok := err.(*ipam.IpamRouteTargetsDeleteDefault); ok {
@@ -132,7 +139,7 @@ func resourceNetboxRouteTargetDelete(d *schema.ResourceData, m interface{}) erro
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId("")
 	return nil
@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxSiteGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxSiteGroupCreate,
-		Read:   resourceNetboxSiteGroupRead,
-		Update: resourceNetboxSiteGroupUpdate,
-		Delete: resourceNetboxSiteGroupDelete,
+		CreateContext: resourceNetboxSiteGroupCreate,
+		ReadContext:   resourceNetboxSiteGroupRead,
+		UpdateContext: resourceNetboxSiteGroupUpdate,
+		DeleteContext: resourceNetboxSiteGroupDelete,
 
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/facilities/#site-groups):
 
@@ -41,6 +43,8 @@ func resourceNetboxSiteGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -48,7 +52,7 @@ func resourceNetboxSiteGroup() *schema.Resource {
 	}
 }
 
-func resourceNetboxSiteGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxSiteGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	name := d.Get("name").(string)
@@ -74,23 +78,24 @@ func resourceNetboxSiteGroupCreate(d *schema.ResourceData, m interface{}) error
 		data.Parent = &parentID
 	}
 
-	params := dcim.NewDcimSiteGroupsCreateParams().WithData(data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := dcim.NewDcimSiteGroupsCreateParams().WithContext(ctx).WithData(data)
 
 	res, err := api.Dcim.DcimSiteGroupsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxSiteGroupRead(d, m)
+	return resourceNetboxSiteGroupRead(ctx, d, m)
 }
 
-func resourceNetboxSiteGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxSiteGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
-	params := dcim.NewDcimSiteGroupsReadParams().WithID(id)
+	params := dcim.NewDcimSiteGroupsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimSiteGroupsRead(params, nil)
 	if err != nil {
@@ -102,7 +107,7 @@ func resourceNetboxSiteGroupRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	siteGroup := res.GetPayload()
@@ -112,10 +117,11 @@ func resourceNetboxSiteGroupRead(d *schema.ResourceData, m interface{}) error {
 	if siteGroup.Parent != nil {
 		d.Set("parent_id", siteGroup.Parent.ID)
 	}
+	api.readCustomFields(d, siteGroup.CustomFields)
 	return nil
 }
 
-func resourceNetboxSiteGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxSiteGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -142,21 +148,22 @@ func resourceNetboxSiteGroupUpdate(d *schema.ResourceData, m interface{}) error
 	if parentID != 0 {
 		data.Parent = &parentID
 	}
-	params := dcim.NewDcimSiteGroupsPartialUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := dcim.NewDcimSiteGroupsPartialUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Dcim.DcimSiteGroupsPartialUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxSiteGroupRead(d, m)
+	return resourceNetboxSiteGroupRead(ctx, d, m)
 }
 
-func resourceNetboxSiteGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxSiteGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimSiteGroupsDeleteParams().WithID(id)
+	params := dcim.NewDcimSiteGroupsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimSiteGroupsDelete(params, nil)
 	if err != nil {
@@ -166,7 +173,7 @@ func resourceNetboxSiteGroupDelete(d *schema.ResourceData, m interface{}) error
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
@@ -102,6 +102,83 @@ resource "netbox_site" "test" {
 	})
 }
 
+func TestAccNetboxSite_customFieldsJSON(t *testing.T) {
+	testSlug := "site_cf_json"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testName, "-", "_")
+	config := func(values string) string {
+		return fmt.Sprintf(`
+resource "netbox_tenant" "test" {
+  count = 2
+  name  = "%[2]s_${count.index}"
+}
+
+resource "netbox_custom_field_choice_set" "test" {
+  name          = "%[2]s"
+  extra_choices = [["a", "A"], ["b", "B"]]
+}
+
+locals {
+  custom_field_types = ["text", "longtext", "integer", "decimal", "boolean", "date", "url", "json", "select", "multiselect", "object", "multiobject"]
+}
+
+resource "netbox_custom_field" "test" {
+  for_each            = toset(local.custom_field_types)
+  name                = "%[1]s_${each.key}"
+  type                = each.key
+  content_types       = ["dcim.site"]
+  choice_set_id       = contains(["select", "multiselect"], each.key) ? netbox_custom_field_choice_set.test.id : null
+  related_object_type = contains(["object", "multiobject"], each.key) ? "tenancy.tenant" : null
+}
+
+resource "netbox_site" "test" {
+  name               = "%[2]s"
+  custom_fields_json = jsonencode(%[3]s)
+
+  depends_on = [netbox_custom_field.test]
+}`, testField, testName, values)
+	}
+	allTypes := fmt.Sprintf(`{
+    %[1]s_text        = "some text"
+    %[1]s_longtext    = "some\nlonger text"
+    %[1]s_integer     = 42
+    %[1]s_decimal     = 4.2
+    %[1]s_boolean     = false
+    %[1]s_date        = "2024-02-29"
+    %[1]s_url         = "https://example.com"
+    %[1]s_json        = { nested = [1, "two", true] }
+    %[1]s_select      = "a"
+    %[1]s_multiselect = ["a", "b"]
+    %[1]s_object      = netbox_tenant.test[0].id
+    %[1]s_multiobject = [netbox_tenant.test[0].id, netbox_tenant.test[1].id]
+  }`, testField)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config(allTypes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields.%", "0"),
+					resource.TestCheckResourceAttrSet("netbox_site.test", "custom_fields_json"),
+				),
+			},
+			{
+				// every type round-trips without a diff
+				Config:   config(allTypes),
+				PlanOnly: true,
+			},
+			{
+				Config: config(fmt.Sprintf(`{ %[1]s_integer = 7 }`, testField)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields_json", fmt.Sprintf(`{"%[1]s_integer":7}`, testField)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetboxSite_fieldUpdate(t *testing.T) {
 	testSlug := "site_field_update"
 	testName := testAccGetTestName(testSlug)
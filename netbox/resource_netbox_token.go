@@ -1,21 +1,23 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/users"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxToken() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxTokenCreate,
-		Read:   resourceNetboxTokenRead,
-		Update: resourceNetboxTokenUpdate,
-		Delete: resourceNetboxTokenDelete,
+		CreateContext: resourceNetboxTokenCreate,
+		ReadContext:   resourceNetboxTokenRead,
+		UpdateContext: resourceNetboxTokenUpdate,
+		DeleteContext: resourceNetboxTokenDelete,
 
 		Description: `:meta:subcategory:Authentication:From the [official documentation](https://docs.netbox.dev/en/stable/rest-api/authentication/#tokens):
 
@@ -64,7 +66,7 @@ func resourceNetboxToken() *schema.Resource {
 	}
 }
 
-func resourceNetboxTokenCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	data := models.WritableToken{}
 
@@ -86,24 +88,24 @@ func resourceNetboxTokenCreate(d *schema.ResourceData, m interface{}) error {
 
 	expires, err := strfmt.ParseDateTime(d.Get("expires").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	data.Expires = &expires
 
-	params := users.NewUsersTokensCreateParams().WithData(&data)
+	params := users.NewUsersTokensCreateParams().WithContext(ctx).WithData(&data)
 	res, err := api.Users.UsersTokensCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxTokenUpdate(d, m)
+	return resourceNetboxTokenUpdate(ctx, d, m)
 }
 
-func resourceNetboxTokenRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := users.NewUsersTokensReadParams().WithID(id)
+	params := users.NewUsersTokensReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Users.UsersTokensRead(params, nil)
 	if err != nil {
@@ -115,7 +117,7 @@ func resourceNetboxTokenRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 	token := res.GetPayload()
 
@@ -133,7 +135,7 @@ func resourceNetboxTokenRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNetboxTokenUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxTokenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableToken{}
@@ -155,22 +157,22 @@ func resourceNetboxTokenUpdate(d *schema.ResourceData, m interface{}) error {
 
 	expires, err := strfmt.ParseDateTime(d.Get("expires").(string))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	data.Expires = &expires
 
-	params := users.NewUsersTokensUpdateParams().WithID(id).WithData(&data)
+	params := users.NewUsersTokensUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err = api.Users.UsersTokensUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
-	return resourceNetboxTokenRead(d, m)
+	return resourceNetboxTokenRead(ctx, d, m)
 }
 
-func resourceNetboxTokenDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := users.NewUsersTokensDeleteParams().WithID(id)
+	params := users.NewUsersTokensDeleteParams().WithContext(ctx).WithID(id)
 	_, err := api.Users.UsersTokensDelete(params, nil)
 	if err != nil {
 		if errresp, ok := err.(*users.UsersTokensDeleteDefault); ok {
@@ -179,7 +181,7 @@ func resourceNetboxTokenDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId("")
 	return nil
@@ -37,8 +37,9 @@ func resourceNetboxVirtualChassis() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -73,22 +74,19 @@ func resourceNetboxVirtualChassisCreate(ctx context.Context, d *schema.ResourceD
 		data.Comments = comments
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	params := dcim.NewDcimVirtualChassisCreateParams().WithData(&data)
+	params := dcim.NewDcimVirtualChassisCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Dcim.DcimVirtualChassisCreate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
@@ -101,7 +99,7 @@ func resourceNetboxVirtualChassisRead(ctx context.Context, d *schema.ResourceDat
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
-	params := dcim.NewDcimVirtualChassisReadParams().WithID(id)
+	params := dcim.NewDcimVirtualChassisReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Dcim.DcimVirtualChassisRead(params, nil)
 	if err != nil {
@@ -122,10 +120,7 @@ func resourceNetboxVirtualChassisRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("description", virtualChassis.Description)
 	d.Set("comments", virtualChassis.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
-	if cf != nil {
-		d.Set(customFieldsKey, cf)
-	}
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 
 	api.readTags(d, virtualChassis.Tags)
 	return nil
@@ -146,13 +141,10 @@ func resourceNetboxVirtualChassisUpdate(ctx context.Context, d *schema.ResourceD
 		data.Domain = domain
 	}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
-	}
+	data.CustomFields = api.customFieldsFromResourceData(d)
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -174,11 +166,11 @@ func resourceNetboxVirtualChassisUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	params := dcim.NewDcimVirtualChassisUpdateParams().WithID(id).WithData(&data)
+	params := dcim.NewDcimVirtualChassisUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err = api.Dcim.DcimVirtualChassisUpdate(params, nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	return resourceNetboxVirtualChassisRead(ctx, d, m)
@@ -188,7 +180,7 @@ func resourceNetboxVirtualChassisDelete(ctx context.Context, d *schema.ResourceD
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimVirtualChassisDeleteParams().WithID(id)
+	params := dcim.NewDcimVirtualChassisDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Dcim.DcimVirtualChassisDelete(params, nil)
 	if err != nil {
@@ -198,17 +190,17 @@ func resourceNetboxVirtualChassisDelete(ctx context.Context, d *schema.ResourceD
 				return nil
 			}
 		}
-		return diag.FromErr(err)
+		return diagFromNetboxError(err, d)
 	}
 
 	return nil
 }
 
-func virtualChassisUpdateMaster(api *providerState, id int64, master *int64) error {
+func virtualChassisUpdateMaster(ctx context.Context, api *providerState, id int64, master *int64) error {
 	// Need to read the virtual chassis because we cannot do a partial update
 	// because setting `master` to nil would omit it entirely, so we need to
 	// do a PUT request instead of PATCH
-	vcRes, err := api.Dcim.DcimVirtualChassisRead(dcim.NewDcimVirtualChassisReadParams().WithID(id), nil)
+	vcRes, err := api.Dcim.DcimVirtualChassisRead(dcim.NewDcimVirtualChassisReadParams().WithContext(ctx).WithID(id), nil)
 	if err != nil {
 		return err
 	}
@@ -234,6 +226,6 @@ func virtualChassisUpdateMaster(api *providerState, id int64, master *int64) err
 		Master:      master,
 	}
 
-	_, err = api.Dcim.DcimVirtualChassisUpdate(dcim.NewDcimVirtualChassisUpdateParams().WithID(id).WithData(&vcUpdateData), nil)
+	_, err = api.Dcim.DcimVirtualChassisUpdate(dcim.NewDcimVirtualChassisUpdateParams().WithContext(ctx).WithID(id).WithData(&vcUpdateData), nil)
 	return err
 }
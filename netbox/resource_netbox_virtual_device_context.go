@@ -0,0 +1,220 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxVirtualDeviceContextStatusOptions = []string{"active", "planned", "offline"}
+
+// The generated client expects the status of virtual device contexts to be a
+// plain string, so they are managed through the REST API directly.
+const virtualDeviceContextsPath = "/dcim/virtual-device-contexts/"
+
+// netboxVirtualDeviceContext is a virtual device context including its status.
+type netboxVirtualDeviceContext struct {
+	models.VirtualDeviceContext
+	Status *struct {
+		Value string `json:"value"`
+	} `json:"status"`
+}
+
+func virtualDeviceContextPath(id int64) string {
+	return fmt.Sprintf("%s%d/", virtualDeviceContextsPath, id)
+}
+
+func resourceNetboxVirtualDeviceContext() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxVirtualDeviceContextCreate,
+		ReadContext:   resourceNetboxVirtualDeviceContextRead,
+		UpdateContext: resourceNetboxVirtualDeviceContextUpdate,
+		DeleteContext: resourceNetboxVirtualDeviceContextDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/virtualdevicecontext/):
+
+> A virtual device context (VDC) represents a logical partition within a physical device, to which interfaces from the parent device can be allocated. Each VDC effectively provides an isolated control plane, but relies on shared resources of the parent device. A VDC is somewhat similar to a virtual machine in that it effects isolation between various components, but stops short of delivering a fully virtualized environment.
+
+Use the ` + "`vdc_ids`" + ` of ` + "`netbox_device_interface`" + ` to assign interfaces to a virtual device context.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"identifier": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 32767),
+				Description:  "Numeric identifier unique to the parent device.",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice(resourceNetboxVirtualDeviceContextStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVirtualDeviceContextStatusOptions),
+			},
+			"primary_ipv4_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"primary_ipv6_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVirtualDeviceContextData(ctx context.Context, api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"device":      d.Get("device_id").(int),
+		"identifier":  getOptionalInt(d, "identifier"),
+		"status":      d.Get("status").(string),
+		"primary_ip4": getOptionalInt(d, "primary_ipv4_id"),
+		"primary_ip6": getOptionalInt(d, "primary_ipv6_id"),
+		"tenant":      getOptionalInt(d, "tenant_id"),
+		"description": d.Get("description").(string),
+		"comments":    d.Get("comments").(string),
+		"tags":        tags,
+	}
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+	return data, nil
+}
+
+func resourceNetboxVirtualDeviceContextCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxVirtualDeviceContextData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var vdc netboxVirtualDeviceContext
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, virtualDeviceContextsPath, nil, data, &vdc); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(vdc.ID, 10))
+
+	return resourceNetboxVirtualDeviceContextRead(ctx, d, m)
+}
+
+func resourceNetboxVirtualDeviceContextRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var vdc netboxVirtualDeviceContext
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, virtualDeviceContextPath(id), nil, nil, &vdc); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", vdc.Name)
+	d.Set("identifier", vdc.Identifier)
+	d.Set("description", vdc.Description)
+	d.Set("comments", vdc.Comments)
+
+	if vdc.Device != nil {
+		d.Set("device_id", vdc.Device.ID)
+	}
+
+	if vdc.Status != nil {
+		d.Set("status", vdc.Status.Value)
+	}
+
+	if vdc.PrimaryIp4 != nil {
+		d.Set("primary_ipv4_id", vdc.PrimaryIp4.ID)
+	} else {
+		d.Set("primary_ipv4_id", nil)
+	}
+
+	if vdc.PrimaryIp6 != nil {
+		d.Set("primary_ipv6_id", vdc.PrimaryIp6.ID)
+	} else {
+		d.Set("primary_ipv6_id", nil)
+	}
+
+	if vdc.Tenant != nil {
+		d.Set("tenant_id", vdc.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	api.readTags(d, vdc.Tags)
+	api.readCustomFields(d, vdc.CustomFields)
+	return nil
+}
+
+func resourceNetboxVirtualDeviceContextUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxVirtualDeviceContextData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPut, virtualDeviceContextPath(id), nil, data, nil); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxVirtualDeviceContextRead(ctx, d, m)
+}
+
+func resourceNetboxVirtualDeviceContextDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodDelete, virtualDeviceContextPath(id), nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxVirtualDeviceContextFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "test" {
+  name = "%[1]s"
+  device_id = netbox_device.test.id
+  type = "1000base-t"
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address = "1.1.31.1/32"
+  status = "active"
+  device_interface_id = netbox_device_interface.test.id
+}
+`, testName)
+}
+
+func TestAccNetboxVirtualDeviceContext_basic(t *testing.T) {
+	testSlug := "vdc_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxVirtualDeviceContextFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_device_context" "test" {
+  name            = "%[1]s"
+  device_id       = netbox_device.test.id
+  identifier      = 1
+  status          = "planned"
+  primary_ipv4_id = netbox_ip_address.test.id
+  tenant_id       = netbox_tenant.test.id
+  description     = "test description"
+  comments        = "test comments"
+  tags            = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "name", testName),
+					resource.TestCheckResourceAttrPair("netbox_virtual_device_context.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "identifier", "1"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "status", "planned"),
+					resource.TestCheckResourceAttrPair("netbox_virtual_device_context.test", "primary_ipv4_id", "netbox_ip_address.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_virtual_device_context.test", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "description", "test description"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "comments", "test comments"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: testAccNetboxVirtualDeviceContextFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_device_context" "test" {
+  name      = "%[1]s"
+  device_id = netbox_device.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "identifier", "0"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "primary_ipv4_id", "0"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "tenant_id", "0"),
+					resource.TestCheckResourceAttr("netbox_virtual_device_context.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_virtual_device_context.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxVirtualDeviceContext_interface(t *testing.T) {
+	testSlug := "vdc_iface"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxVirtualDeviceContextFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_device_context" "test" {
+  name      = "%[1]s"
+  device_id = netbox_device.test.id
+}
+
+resource "netbox_device_interface" "vdc" {
+  name      = "%[1]s_vdc"
+  device_id = netbox_device.test.id
+  type      = "1000base-t"
+  vdc_ids   = [netbox_virtual_device_context.test.id]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.vdc", "vdc_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_device_interface.vdc", "vdc_ids.0", "netbox_virtual_device_context.test", "id"),
+				),
+			},
+			{
+				Config: testAccNetboxVirtualDeviceContextFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_virtual_device_context" "test" {
+  name      = "%[1]s"
+  device_id = netbox_device.test.id
+}
+
+resource "netbox_device_interface" "vdc" {
+  name      = "%[1]s_vdc"
+  device_id = netbox_device.test.id
+  type      = "1000base-t"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interface.vdc", "vdc_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_virtual_device_context", &resource.Sweeper{
+		Name:         "netbox_virtual_device_context",
+		Dependencies: []string{},
+		F: func(region string) error {
+			return testAccSweepObjectsByName(region, virtualDeviceContextsPath)
+		},
+	})
+}
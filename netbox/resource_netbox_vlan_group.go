@@ -1,10 +1,12 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -13,10 +15,10 @@ var resourceNetboxVlanGroupScopeTypeOptions = []string{"dcim.location", "dcim.si
 
 func resourceNetboxVlanGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxVlanGroupCreate,
-		Read:   resourceNetboxVlanGroupRead,
-		Update: resourceNetboxVlanGroupUpdate,
-		Delete: resourceNetboxVlanGroupDelete,
+		CreateContext: resourceNetboxVlanGroupCreate,
+		ReadContext:   resourceNetboxVlanGroupRead,
+		UpdateContext: resourceNetboxVlanGroupUpdate,
+		DeleteContext: resourceNetboxVlanGroupDelete,
 
 		Description: `:meta:subcategory:IP Address Management (IPAM):
 
@@ -58,7 +60,9 @@ func resourceNetboxVlanGroup() *schema.Resource {
 				},
 				Required: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -66,7 +70,7 @@ func resourceNetboxVlanGroup() *schema.Resource {
 	}
 }
 
-func resourceNetboxVlanGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVlanGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	data := models.VLANGroup{}
 
@@ -98,25 +102,26 @@ func resourceNetboxVlanGroupCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	params := ipam.NewIpamVlanGroupsCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamVlanGroupsCreateParams().WithContext(ctx).WithData(&data)
 	res, err := api.Ipam.IpamVlanGroupsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxVlanGroupRead(d, m)
+	return resourceNetboxVlanGroupRead(ctx, d, m)
 }
 
-func resourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVlanGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamVlanGroupsReadParams().WithID(id)
+	params := ipam.NewIpamVlanGroupsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Ipam.IpamVlanGroupsRead(params, nil)
 	if err != nil {
@@ -128,7 +133,7 @@ func resourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	vlanGroup := res.GetPayload()
@@ -138,6 +143,7 @@ func resourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", vlanGroup.Description)
 	d.Set("vid_ranges", vlanGroup.VidRanges)
 	api.readTags(d, vlanGroup.Tags)
+	api.readCustomFields(d, vlanGroup.CustomFields)
 
 	if vlanGroup.ScopeType != nil {
 		d.Set("scope_type", vlanGroup.ScopeType)
@@ -150,7 +156,7 @@ func resourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNetboxVlanGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVlanGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.VLANGroup{}
@@ -184,26 +190,27 @@ func resourceNetboxVlanGroupUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	var err error
-	data.Tags, err = getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	params := ipam.NewIpamVlanGroupsUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := ipam.NewIpamVlanGroupsUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 	_, err = api.Ipam.IpamVlanGroupsUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
-	return resourceNetboxVlanGroupRead(d, m)
+	return resourceNetboxVlanGroupRead(ctx, d, m)
 }
 
-func resourceNetboxVlanGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVlanGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamVlanGroupsDeleteParams().WithID(id)
+	params := ipam.NewIpamVlanGroupsDeleteParams().WithContext(ctx).WithID(id)
 	_, err := api.Ipam.IpamVlanGroupsDelete(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	return nil
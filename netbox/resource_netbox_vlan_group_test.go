@@ -95,6 +95,40 @@ resource "netbox_vlan_group" "test_with_dependencies" {
 	})
 }
 
+func TestAccNetboxVlanGroup_customFields(t *testing.T) {
+	testSlug := "vlan_group_cf"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["ipam.vlangroup"]
+}
+
+resource "netbox_vlan_group" "test" {
+  name          = "%[2]s"
+  slug          = "%[2]s"
+  custom_fields = {"${netbox_custom_field.test.name}" = "81"}
+}`, testField, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vlan_group.test", "custom_fields."+testField, "81"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vlan_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_vlan_group", &resource.Sweeper{
 		Name:         "netbox_vlan_group",
@@ -108,6 +108,49 @@ resource "netbox_vlan" "test_with_dependencies" {
 	})
 }
 
+func TestAccNetboxVlan_customFields(t *testing.T) {
+	testSlug := "vlan_cf"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	testConfig := func(value string) string {
+		return fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["ipam.vlan"]
+}
+
+resource "netbox_vlan" "test" {
+  name          = "%[2]s"
+  vid           = 778
+  custom_fields = {"${netbox_custom_field.test.name}" = "%[3]s"}
+}`, testField, testName, value)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig("core"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vlan.test", "custom_fields."+testField, "core"),
+				),
+			},
+			{
+				Config: testConfig("edge"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vlan.test", "custom_fields."+testField, "edge"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vlan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_vlan", &resource.Sweeper{
 		Name:         "netbox_vlan",
@@ -0,0 +1,207 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxVpnIkePolicyModeOptions = []string{"aggressive", "main"}
+
+const vpnIkePoliciesPath = "/vpn/ike-policies/"
+
+// netboxVpnIkePolicy is an IKE policy as returned by the Netbox API.
+type netboxVpnIkePolicy struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Version     struct {
+		Value int64 `json:"value"`
+	} `json:"version"`
+	Mode *struct {
+		Value string `json:"value"`
+	} `json:"mode"`
+	Proposals []struct {
+		ID int64 `json:"id"`
+	} `json:"proposals"`
+	PresharedKey string              `json:"preshared_key"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields"`
+}
+
+func vpnIkePolicyPath(id int64) string {
+	return fmt.Sprintf("%s%d/", vpnIkePoliciesPath, id)
+}
+
+func resourceNetboxVpnIkePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxVpnIkePolicyCreate,
+		ReadContext:   resourceNetboxVpnIkePolicyRead,
+		UpdateContext: resourceNetboxVpnIkePolicyUpdate,
+		DeleteContext: resourceNetboxVpnIkePolicyDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ikepolicy/):
+
+> An Internet Key Exchange (IKE) policy defines an IKE version, mode, and set of proposals to be used in IKE negotiation. These policies are referenced by IPSec profiles.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2}),
+				Description:  "The IKE version. Valid values are `1` and `2`.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnIkePolicyModeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnIkePolicyModeOptions) + ". Only used by IKEv1.",
+			},
+			"proposal_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the `netbox_vpn_ike_proposal`s of the policy.",
+			},
+			"preshared_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVpnIkePolicyData(ctx context.Context, api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"description":   d.Get("description").(string),
+		"version":       d.Get("version").(int),
+		"mode":          d.Get("mode").(string),
+		"proposals":     toInt64List(d.Get("proposal_ids")),
+		"preshared_key": d.Get("preshared_key").(string),
+		"comments":      d.Get("comments").(string),
+		"tags":          tags,
+	}
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+	return data, nil
+}
+
+func resourceNetboxVpnIkePolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxVpnIkePolicyData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var policy netboxVpnIkePolicy
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, vpnIkePoliciesPath, nil, data, &policy); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(policy.ID, 10))
+
+	return resourceNetboxVpnIkePolicyRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIkePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var policy netboxVpnIkePolicy
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, vpnIkePolicyPath(id), nil, nil, &policy); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("version", policy.Version.Value)
+	d.Set("preshared_key", policy.PresharedKey)
+	d.Set("comments", policy.Comments)
+
+	if policy.Mode != nil {
+		d.Set("mode", policy.Mode.Value)
+	} else {
+		d.Set("mode", nil)
+	}
+
+	var proposalIDs []int64
+	for _, proposal := range policy.Proposals {
+		proposalIDs = append(proposalIDs, proposal.ID)
+	}
+	d.Set("proposal_ids", proposalIDs)
+
+	api.readTags(d, policy.Tags)
+	api.readCustomFields(d, policy.CustomFields)
+	return nil
+}
+
+func resourceNetboxVpnIkePolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxVpnIkePolicyData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPut, vpnIkePolicyPath(id), nil, data, nil); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxVpnIkePolicyRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIkePolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodDelete, vpnIkePolicyPath(id), nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxVpnIkePolicy_basic(t *testing.T) {
+	testSlug := "ike_policy"
+	testName := testAccGetTestName(testSlug)
+	dependencies := fmt.Sprintf(`
+resource "netbox_vpn_ike_proposal" "test" {
+  name                  = "%[1]s"
+  authentication_method = "preshared-keys"
+  encryption_algorithm  = "aes-256-cbc"
+  group                 = 14
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_vpn_ike_policy" "test" {
+  name          = "%[1]s"
+  description   = "test description"
+  version       = 1
+  mode          = "main"
+  proposal_ids  = [netbox_vpn_ike_proposal.test.id]
+  preshared_key = "secret"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_ike_policy.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_policy.test", "description", "test description"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_policy.test", "version", "1"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_policy.test", "mode", "main"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_policy.test", "proposal_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_vpn_ike_policy.test", "proposal_ids.0", "netbox_vpn_ike_proposal.test", "id"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_policy.test", "preshared_key", "secret"),
+				),
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_vpn_ike_policy" "test" {
+  name    = "%[1]s"
+  version = 2
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_ike_policy.test", "version", "2"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_policy.test", "mode", ""),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_policy.test", "proposal_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_policy.test", "preshared_key", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_vpn_ike_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_vpn_ike_policy", &resource.Sweeper{
+		Name:         "netbox_vpn_ike_policy",
+		Dependencies: []string{"netbox_vpn_ipsec_profile"},
+		F: func(region string) error {
+			return testAccSweepObjectsByName(region, vpnIkePoliciesPath)
+		},
+	})
+}
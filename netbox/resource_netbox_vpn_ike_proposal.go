@@ -0,0 +1,219 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxVpnAuthenticationMethodOptions = []string{"preshared-keys", "certificates", "rsa-signatures", "dsa-signatures"}
+var resourceNetboxVpnEncryptionAlgorithmOptions = []string{"aes-128-cbc", "aes-128-gcm", "aes-192-cbc", "aes-192-gcm", "aes-256-cbc", "aes-256-gcm", "3des-cbc", "des-cbc"}
+var resourceNetboxVpnAuthenticationAlgorithmOptions = []string{"hmac-sha1", "hmac-sha256", "hmac-sha384", "hmac-sha512", "hmac-md5"}
+var resourceNetboxVpnDHGroupOptions = []int{1, 2, 5, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34}
+
+// The generated client does not cover the IKE and IPSec models, so they are
+// managed through the REST API directly.
+const vpnIkeProposalsPath = "/vpn/ike-proposals/"
+
+// netboxVpnIkeProposal is an IKE proposal as returned by the Netbox API.
+type netboxVpnIkeProposal struct {
+	ID                   int64  `json:"id"`
+	Name                 string `json:"name"`
+	Description          string `json:"description"`
+	AuthenticationMethod struct {
+		Value string `json:"value"`
+	} `json:"authentication_method"`
+	EncryptionAlgorithm struct {
+		Value string `json:"value"`
+	} `json:"encryption_algorithm"`
+	AuthenticationAlgorithm *struct {
+		Value string `json:"value"`
+	} `json:"authentication_algorithm"`
+	Group struct {
+		Value int64 `json:"value"`
+	} `json:"group"`
+	SaLifetime   *int64              `json:"sa_lifetime"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields"`
+}
+
+func vpnIkeProposalPath(id int64) string {
+	return fmt.Sprintf("%s%d/", vpnIkeProposalsPath, id)
+}
+
+func resourceNetboxVpnIkeProposal() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxVpnIkeProposalCreate,
+		ReadContext:   resourceNetboxVpnIkeProposalRead,
+		UpdateContext: resourceNetboxVpnIkeProposalUpdate,
+		DeleteContext: resourceNetboxVpnIkeProposalDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ikeproposal/):
+
+> An Internet Key Exchange (IKE) proposal defines a set of parameters used to establish a secure bidirectional connection across an untrusted medium, such as the Internet. IKE proposals defined in NetBox can be referenced by IKE policies, which can in turn be referenced by IPSec profiles.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"authentication_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnAuthenticationMethodOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnAuthenticationMethodOptions),
+			},
+			"encryption_algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnEncryptionAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnEncryptionAlgorithmOptions),
+			},
+			"authentication_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnAuthenticationAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnAuthenticationAlgorithmOptions),
+			},
+			"group": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice(resourceNetboxVpnDHGroupOptions),
+				Description:  "The Diffie-Hellman group. Valid values are `1`, `2`, `5` and `14` to `34`.",
+			},
+			"sa_lifetime": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The security association lifetime in seconds.",
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVpnIkeProposalData(ctx context.Context, api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"name":                     d.Get("name").(string),
+		"description":              d.Get("description").(string),
+		"authentication_method":    d.Get("authentication_method").(string),
+		"encryption_algorithm":     d.Get("encryption_algorithm").(string),
+		"authentication_algorithm": d.Get("authentication_algorithm").(string),
+		"group":                    d.Get("group").(int),
+		"sa_lifetime":              getOptionalInt(d, "sa_lifetime"),
+		"comments":                 d.Get("comments").(string),
+		"tags":                     tags,
+	}
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+	return data, nil
+}
+
+func resourceNetboxVpnIkeProposalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxVpnIkeProposalData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var proposal netboxVpnIkeProposal
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, vpnIkeProposalsPath, nil, data, &proposal); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(proposal.ID, 10))
+
+	return resourceNetboxVpnIkeProposalRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIkeProposalRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var proposal netboxVpnIkeProposal
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, vpnIkeProposalPath(id), nil, nil, &proposal); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", proposal.Name)
+	d.Set("description", proposal.Description)
+	d.Set("authentication_method", proposal.AuthenticationMethod.Value)
+	d.Set("encryption_algorithm", proposal.EncryptionAlgorithm.Value)
+	d.Set("group", proposal.Group.Value)
+	d.Set("sa_lifetime", proposal.SaLifetime)
+	d.Set("comments", proposal.Comments)
+
+	if proposal.AuthenticationAlgorithm != nil {
+		d.Set("authentication_algorithm", proposal.AuthenticationAlgorithm.Value)
+	} else {
+		d.Set("authentication_algorithm", nil)
+	}
+
+	api.readTags(d, proposal.Tags)
+	api.readCustomFields(d, proposal.CustomFields)
+	return nil
+}
+
+func resourceNetboxVpnIkeProposalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxVpnIkeProposalData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPut, vpnIkeProposalPath(id), nil, data, nil); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxVpnIkeProposalRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIkeProposalDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodDelete, vpnIkeProposalPath(id), nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxVpnIkeProposal_basic(t *testing.T) {
+	testSlug := "ike_proposal"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_vpn_ike_proposal" "test" {
+  name                     = "%[1]s"
+  description              = "test description"
+  authentication_method    = "preshared-keys"
+  encryption_algorithm     = "aes-256-gcm"
+  authentication_algorithm = "hmac-sha256"
+  group                    = 19
+  sa_lifetime              = 28800
+  comments                 = "test comments"
+  tags                     = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "description", "test description"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "authentication_method", "preshared-keys"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "encryption_algorithm", "aes-256-gcm"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "authentication_algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "group", "19"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "sa_lifetime", "28800"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "comments", "test comments"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vpn_ike_proposal" "test" {
+  name                  = "%[1]s"
+  authentication_method = "certificates"
+  encryption_algorithm  = "aes-128-cbc"
+  group                 = 14
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "authentication_method", "certificates"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "encryption_algorithm", "aes-128-cbc"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "authentication_algorithm", ""),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "group", "14"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "sa_lifetime", "0"),
+					resource.TestCheckResourceAttr("netbox_vpn_ike_proposal.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vpn_ike_proposal.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_vpn_ike_proposal", &resource.Sweeper{
+		Name:         "netbox_vpn_ike_proposal",
+		Dependencies: []string{"netbox_vpn_ike_policy"},
+		F: func(region string) error {
+			return testAccSweepObjectsByName(region, vpnIkeProposalsPath)
+		},
+	})
+}
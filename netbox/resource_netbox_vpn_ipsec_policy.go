@@ -0,0 +1,186 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const vpnIpsecPoliciesPath = "/vpn/ipsec-policies/"
+
+// netboxVpnIpsecPolicy is an IPSec policy as returned by the Netbox API.
+type netboxVpnIpsecPolicy struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Proposals   []struct {
+		ID int64 `json:"id"`
+	} `json:"proposals"`
+	PfsGroup *struct {
+		Value int64 `json:"value"`
+	} `json:"pfs_group"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields"`
+}
+
+func vpnIpsecPolicyPath(id int64) string {
+	return fmt.Sprintf("%s%d/", vpnIpsecPoliciesPath, id)
+}
+
+func resourceNetboxVpnIpsecPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxVpnIpsecPolicyCreate,
+		ReadContext:   resourceNetboxVpnIpsecPolicyRead,
+		UpdateContext: resourceNetboxVpnIpsecPolicyUpdate,
+		DeleteContext: resourceNetboxVpnIpsecPolicyDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecpolicy/):
+
+> An IPSec policy defines a set of proposals to be used in the formation of IPSec tunnels. A perfect forward secrecy (PFS) group may optionally also be defined. These policies are referenced by IPSec profiles.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"proposal_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the `netbox_vpn_ipsec_proposal`s of the policy.",
+			},
+			"pfs_group": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice(resourceNetboxVpnDHGroupOptions),
+				Description:  "The Diffie-Hellman group for perfect forward secrecy. Valid values are `1`, `2`, `5` and `14` to `34`.",
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVpnIpsecPolicyData(ctx context.Context, api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"proposals":   toInt64List(d.Get("proposal_ids")),
+		"pfs_group":   getOptionalInt(d, "pfs_group"),
+		"comments":    d.Get("comments").(string),
+		"tags":        tags,
+	}
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+	return data, nil
+}
+
+func resourceNetboxVpnIpsecPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxVpnIpsecPolicyData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var policy netboxVpnIpsecPolicy
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, vpnIpsecPoliciesPath, nil, data, &policy); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(policy.ID, 10))
+
+	return resourceNetboxVpnIpsecPolicyRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIpsecPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var policy netboxVpnIpsecPolicy
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, vpnIpsecPolicyPath(id), nil, nil, &policy); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("comments", policy.Comments)
+
+	if policy.PfsGroup != nil {
+		d.Set("pfs_group", policy.PfsGroup.Value)
+	} else {
+		d.Set("pfs_group", nil)
+	}
+
+	var proposalIDs []int64
+	for _, proposal := range policy.Proposals {
+		proposalIDs = append(proposalIDs, proposal.ID)
+	}
+	d.Set("proposal_ids", proposalIDs)
+
+	api.readTags(d, policy.Tags)
+	api.readCustomFields(d, policy.CustomFields)
+	return nil
+}
+
+func resourceNetboxVpnIpsecPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxVpnIpsecPolicyData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPut, vpnIpsecPolicyPath(id), nil, data, nil); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxVpnIpsecPolicyRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIpsecPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodDelete, vpnIpsecPolicyPath(id), nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
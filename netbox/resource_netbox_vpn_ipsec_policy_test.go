@@ -0,0 +1,66 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxVpnIpsecPolicy_basic(t *testing.T) {
+	testSlug := "ipsec_policy"
+	testName := testAccGetTestName(testSlug)
+	dependencies := fmt.Sprintf(`
+resource "netbox_vpn_ipsec_proposal" "test" {
+  name                 = "%[1]s"
+  encryption_algorithm = "aes-256-gcm"
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_vpn_ipsec_policy" "test" {
+  name         = "%[1]s"
+  description  = "test description"
+  proposal_ids = [netbox_vpn_ipsec_proposal.test.id]
+  pfs_group    = 20
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_policy.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_policy.test", "description", "test description"),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_policy.test", "proposal_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_vpn_ipsec_policy.test", "proposal_ids.0", "netbox_vpn_ipsec_proposal.test", "id"),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_policy.test", "pfs_group", "20"),
+				),
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_vpn_ipsec_policy" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_policy.test", "proposal_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_policy.test", "pfs_group", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vpn_ipsec_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_vpn_ipsec_policy", &resource.Sweeper{
+		Name:         "netbox_vpn_ipsec_policy",
+		Dependencies: []string{"netbox_vpn_ipsec_profile"},
+		F: func(region string) error {
+			return testAccSweepObjectsByName(region, vpnIpsecPoliciesPath)
+		},
+	})
+}
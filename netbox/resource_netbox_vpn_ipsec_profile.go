@@ -0,0 +1,187 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxVpnIpsecProfileModeOptions = []string{"esp", "ah"}
+
+const vpnIpsecProfilesPath = "/vpn/ipsec-profiles/"
+
+// netboxVpnIpsecProfile is an IPSec profile as returned by the Netbox API.
+type netboxVpnIpsecProfile struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Mode        struct {
+		Value string `json:"value"`
+	} `json:"mode"`
+	IkePolicy struct {
+		ID int64 `json:"id"`
+	} `json:"ike_policy"`
+	IpsecPolicy struct {
+		ID int64 `json:"id"`
+	} `json:"ipsec_policy"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields"`
+}
+
+func vpnIpsecProfilePath(id int64) string {
+	return fmt.Sprintf("%s%d/", vpnIpsecProfilesPath, id)
+}
+
+func resourceNetboxVpnIpsecProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxVpnIpsecProfileCreate,
+		ReadContext:   resourceNetboxVpnIpsecProfileRead,
+		UpdateContext: resourceNetboxVpnIpsecProfileUpdate,
+		DeleteContext: resourceNetboxVpnIpsecProfileDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecprofile/):
+
+> An IPSec profile defines an IKE policy, IPSec policy, and IPSec mode used for establishing an IPSec tunnel.
+
+Use the ` + "`ipsec_profile_id`" + ` of ` + "`netbox_vpn_tunnel`" + ` to assign a profile to a tunnel.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnIpsecProfileModeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnIpsecProfileModeOptions),
+			},
+			"ike_policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"ipsec_policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVpnIpsecProfileData(ctx context.Context, api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"name":         d.Get("name").(string),
+		"description":  d.Get("description").(string),
+		"mode":         d.Get("mode").(string),
+		"ike_policy":   d.Get("ike_policy_id").(int),
+		"ipsec_policy": d.Get("ipsec_policy_id").(int),
+		"comments":     d.Get("comments").(string),
+		"tags":         tags,
+	}
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+	return data, nil
+}
+
+func resourceNetboxVpnIpsecProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxVpnIpsecProfileData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var profile netboxVpnIpsecProfile
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, vpnIpsecProfilesPath, nil, data, &profile); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(profile.ID, 10))
+
+	return resourceNetboxVpnIpsecProfileRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIpsecProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var profile netboxVpnIpsecProfile
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, vpnIpsecProfilePath(id), nil, nil, &profile); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("mode", profile.Mode.Value)
+	d.Set("ike_policy_id", profile.IkePolicy.ID)
+	d.Set("ipsec_policy_id", profile.IpsecPolicy.ID)
+	d.Set("comments", profile.Comments)
+
+	api.readTags(d, profile.Tags)
+	api.readCustomFields(d, profile.CustomFields)
+	return nil
+}
+
+func resourceNetboxVpnIpsecProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxVpnIpsecProfileData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPut, vpnIpsecProfilePath(id), nil, data, nil); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxVpnIpsecProfileRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIpsecProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodDelete, vpnIpsecProfilePath(id), nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxVpnIpsecProfileFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_vpn_ike_proposal" "test" {
+  name                  = "%[1]s"
+  authentication_method = "preshared-keys"
+  encryption_algorithm  = "aes-256-cbc"
+  group                 = 14
+}
+
+resource "netbox_vpn_ike_policy" "test" {
+  name         = "%[1]s"
+  version      = 2
+  proposal_ids = [netbox_vpn_ike_proposal.test.id]
+}
+
+resource "netbox_vpn_ipsec_proposal" "test" {
+  name                 = "%[1]s"
+  encryption_algorithm = "aes-256-gcm"
+}
+
+resource "netbox_vpn_ipsec_policy" "test" {
+  name         = "%[1]s"
+  proposal_ids = [netbox_vpn_ipsec_proposal.test.id]
+}
+
+resource "netbox_vpn_tunnel_group" "test" {
+  name = "%[1]s"
+}
+`, testName)
+}
+
+func TestAccNetboxVpnIpsecProfile_basic(t *testing.T) {
+	testSlug := "ipsec_profile"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxVpnIpsecProfileFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_vpn_ipsec_profile" "test" {
+  name            = "%[1]s"
+  description     = "test description"
+  mode            = "esp"
+  ike_policy_id   = netbox_vpn_ike_policy.test.id
+  ipsec_policy_id = netbox_vpn_ipsec_policy.test.id
+}
+
+resource "netbox_vpn_tunnel" "test" {
+  name             = "%[1]s"
+  encapsulation    = "ipsec-tunnel"
+  status           = "active"
+  tunnel_group_id  = netbox_vpn_tunnel_group.test.id
+  ipsec_profile_id = netbox_vpn_ipsec_profile.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_profile.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_profile.test", "description", "test description"),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_profile.test", "mode", "esp"),
+					resource.TestCheckResourceAttrPair("netbox_vpn_ipsec_profile.test", "ike_policy_id", "netbox_vpn_ike_policy.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_vpn_ipsec_profile.test", "ipsec_policy_id", "netbox_vpn_ipsec_policy.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_vpn_tunnel.test", "ipsec_profile_id", "netbox_vpn_ipsec_profile.test", "id"),
+				),
+			},
+			{
+				Config: testAccNetboxVpnIpsecProfileFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_vpn_ipsec_profile" "test" {
+  name            = "%[1]s"
+  description     = "test description"
+  mode            = "esp"
+  ike_policy_id   = netbox_vpn_ike_policy.test.id
+  ipsec_policy_id = netbox_vpn_ipsec_policy.test.id
+}
+
+resource "netbox_vpn_tunnel" "test" {
+  name            = "%[1]s"
+  encapsulation   = "ipsec-tunnel"
+  status          = "active"
+  tunnel_group_id = netbox_vpn_tunnel_group.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_tunnel.test", "ipsec_profile_id", "0"),
+				),
+			},
+			{
+				Config: testAccNetboxVpnIpsecProfileFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_vpn_ipsec_profile" "test" {
+  name            = "%[1]s"
+  mode            = "ah"
+  ike_policy_id   = netbox_vpn_ike_policy.test.id
+  ipsec_policy_id = netbox_vpn_ipsec_policy.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_profile.test", "mode", "ah"),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_profile.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_vpn_ipsec_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_vpn_ipsec_profile", &resource.Sweeper{
+		Name:         "netbox_vpn_ipsec_profile",
+		Dependencies: []string{"netbox_vpn_tunnel"},
+		F: func(region string) error {
+			return testAccSweepObjectsByName(region, vpnIpsecProfilesPath)
+		},
+	})
+}
@@ -0,0 +1,204 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const vpnIpsecProposalsPath = "/vpn/ipsec-proposals/"
+
+// netboxVpnIpsecProposal is an IPSec proposal as returned by the Netbox API.
+type netboxVpnIpsecProposal struct {
+	ID                  int64  `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	EncryptionAlgorithm *struct {
+		Value string `json:"value"`
+	} `json:"encryption_algorithm"`
+	AuthenticationAlgorithm *struct {
+		Value string `json:"value"`
+	} `json:"authentication_algorithm"`
+	SaLifetimeSeconds *int64              `json:"sa_lifetime_seconds"`
+	SaLifetimeData    *int64              `json:"sa_lifetime_data"`
+	Comments          string              `json:"comments"`
+	Tags              []*models.NestedTag `json:"tags"`
+	CustomFields      interface{}         `json:"custom_fields"`
+}
+
+func vpnIpsecProposalPath(id int64) string {
+	return fmt.Sprintf("%s%d/", vpnIpsecProposalsPath, id)
+}
+
+func resourceNetboxVpnIpsecProposal() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxVpnIpsecProposalCreate,
+		ReadContext:   resourceNetboxVpnIpsecProposalRead,
+		UpdateContext: resourceNetboxVpnIpsecProposalUpdate,
+		DeleteContext: resourceNetboxVpnIpsecProposalDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecproposal/):
+
+> An IPSec proposal defines a set of parameters used in negotiating security associations for IPSec tunnels. IPSec proposals defined in NetBox can be referenced by IPSec policies, which can in turn be referenced by IPSec profiles.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"encryption_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnEncryptionAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnEncryptionAlgorithmOptions),
+			},
+			"authentication_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnAuthenticationAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnAuthenticationAlgorithmOptions),
+			},
+			"sa_lifetime_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The security association lifetime in seconds.",
+			},
+			"sa_lifetime_data": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The security association lifetime in kilobytes.",
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxVpnIpsecProposalData(ctx context.Context, api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"name":                     d.Get("name").(string),
+		"description":              d.Get("description").(string),
+		"encryption_algorithm":     d.Get("encryption_algorithm").(string),
+		"authentication_algorithm": d.Get("authentication_algorithm").(string),
+		"sa_lifetime_seconds":      getOptionalInt(d, "sa_lifetime_seconds"),
+		"sa_lifetime_data":         getOptionalInt(d, "sa_lifetime_data"),
+		"comments":                 d.Get("comments").(string),
+		"tags":                     tags,
+	}
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+	return data, nil
+}
+
+func resourceNetboxVpnIpsecProposalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxVpnIpsecProposalData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var proposal netboxVpnIpsecProposal
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, vpnIpsecProposalsPath, nil, data, &proposal); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(proposal.ID, 10))
+
+	return resourceNetboxVpnIpsecProposalRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIpsecProposalRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var proposal netboxVpnIpsecProposal
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, vpnIpsecProposalPath(id), nil, nil, &proposal); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", proposal.Name)
+	d.Set("description", proposal.Description)
+	d.Set("sa_lifetime_seconds", proposal.SaLifetimeSeconds)
+	d.Set("sa_lifetime_data", proposal.SaLifetimeData)
+	d.Set("comments", proposal.Comments)
+
+	if proposal.EncryptionAlgorithm != nil {
+		d.Set("encryption_algorithm", proposal.EncryptionAlgorithm.Value)
+	} else {
+		d.Set("encryption_algorithm", nil)
+	}
+
+	if proposal.AuthenticationAlgorithm != nil {
+		d.Set("authentication_algorithm", proposal.AuthenticationAlgorithm.Value)
+	} else {
+		d.Set("authentication_algorithm", nil)
+	}
+
+	api.readTags(d, proposal.Tags)
+	api.readCustomFields(d, proposal.CustomFields)
+	return nil
+}
+
+func resourceNetboxVpnIpsecProposalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxVpnIpsecProposalData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPut, vpnIpsecProposalPath(id), nil, data, nil); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxVpnIpsecProposalRead(ctx, d, m)
+}
+
+func resourceNetboxVpnIpsecProposalDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodDelete, vpnIpsecProposalPath(id), nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
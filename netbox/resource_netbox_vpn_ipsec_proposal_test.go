@@ -0,0 +1,66 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxVpnIpsecProposal_basic(t *testing.T) {
+	testSlug := "ipsec_proposal"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vpn_ipsec_proposal" "test" {
+  name                     = "%[1]s"
+  description              = "test description"
+  encryption_algorithm     = "aes-256-gcm"
+  authentication_algorithm = "hmac-sha512"
+  sa_lifetime_seconds      = 3600
+  sa_lifetime_data         = 4608000
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_proposal.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_proposal.test", "description", "test description"),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_proposal.test", "encryption_algorithm", "aes-256-gcm"),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_proposal.test", "authentication_algorithm", "hmac-sha512"),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_proposal.test", "sa_lifetime_seconds", "3600"),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_proposal.test", "sa_lifetime_data", "4608000"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vpn_ipsec_proposal" "test" {
+  name                 = "%[1]s"
+  encryption_algorithm = "aes-128-gcm"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_proposal.test", "encryption_algorithm", "aes-128-gcm"),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_proposal.test", "authentication_algorithm", ""),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_proposal.test", "sa_lifetime_seconds", "0"),
+					resource.TestCheckResourceAttr("netbox_vpn_ipsec_proposal.test", "sa_lifetime_data", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vpn_ipsec_proposal.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_vpn_ipsec_proposal", &resource.Sweeper{
+		Name:         "netbox_vpn_ipsec_proposal",
+		Dependencies: []string{"netbox_vpn_ipsec_policy"},
+		F: func(region string) error {
+			return testAccSweepObjectsByName(region, vpnIpsecProposalsPath)
+		},
+	})
+}
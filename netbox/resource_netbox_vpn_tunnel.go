@@ -1,22 +1,43 @@
 package netbox
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/vpn"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var resourceNetboxVpnTunnelEncapsulationOptions = []string{"ipsec-transport", "ipsec-tunnel", "ip-ip", "gre"}
 var resourceNetboxVpnTunnelStatusOptions = []string{"planned", "active", "disabled"}
 
+// The generated client neither returns the IPSec profile of tunnels nor can
+// it remove optional references like the IPSec profile or the tenant, so
+// tunnels are written and read through the REST API directly.
+const vpnTunnelsPath = "/vpn/tunnels/"
+
+// netboxVpnTunnel is a tunnel including its IPSec profile.
+type netboxVpnTunnel struct {
+	models.Tunnel
+	IpsecProfile *struct {
+		ID int64 `json:"id"`
+	} `json:"ipsec_profile"`
+}
+
+func vpnTunnelPath(id int64) string {
+	return fmt.Sprintf("%s%d/", vpnTunnelsPath, id)
+}
+
 func resourceNetboxVpnTunnel() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxVpnTunnelCreate,
-		Read:   resourceNetboxVpnTunnelRead,
-		Update: resourceNetboxVpnTunnelUpdate,
-		Delete: resourceNetboxVpnTunnelDelete,
+		CreateContext: resourceNetboxVpnTunnelCreate,
+		ReadContext:   resourceNetboxVpnTunnelRead,
+		UpdateContext: resourceNetboxVpnTunnelUpdate,
+		DeleteContext: resourceNetboxVpnTunnelDelete,
 
 		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/features/vpn-tunnels/):
 
@@ -53,7 +74,14 @@ func resourceNetboxVpnTunnel() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			"ipsec_profile_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of the `netbox_vpn_ipsec_profile` of the tunnel.",
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -61,55 +89,61 @@ func resourceNetboxVpnTunnel() *schema.Resource {
 	}
 }
 
-func resourceNetboxVpnTunnelCreate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*providerState)
-
-	data := models.WritableTunnel{}
-
-	data.Name = strToPtr(d.Get("name").(string))
-	data.Encapsulation = strToPtr(d.Get("encapsulation").(string))
-	data.Status = strToPtr(d.Get("status").(string))
-	data.Group = int64ToPtr(int64(d.Get("tunnel_group_id").(int)))
-
-	data.Description = getOptionalStr(d, "description", false)
-	data.Tenant = getOptionalInt(d, "tenant_id")
-	data.TunnelID = getOptionalInt(d, "tunnel_id")
+func resourceNetboxVpnTunnelData(ctx context.Context, api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
-	data.Tags = tags
+	data := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"encapsulation": d.Get("encapsulation").(string),
+		"status":        d.Get("status").(string),
+		"group":         d.Get("tunnel_group_id").(int),
+		"description":   d.Get("description").(string),
+		"tenant":        getOptionalInt(d, "tenant_id"),
+		"tunnel_id":     getOptionalInt(d, "tunnel_id"),
+		"ipsec_profile": getOptionalInt(d, "ipsec_profile_id"),
+		"tags":          tags,
+	}
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+	return data, nil
+}
 
-	params := vpn.NewVpnTunnelsCreateParams().WithData(&data)
+func resourceNetboxVpnTunnelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
 
-	res, err := api.Vpn.VpnTunnelsCreate(params, nil)
+	data, err := resourceNetboxVpnTunnelData(ctx, api, d)
 	if err != nil {
-		//return errors.New(getTextFromError(err))
-		return err
+		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+	var tunnel netboxVpnTunnel
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, vpnTunnelsPath, nil, data, &tunnel); err != nil {
+		return diagFromNetboxError(err, d)
+	}
 
-	return resourceNetboxVpnTunnelRead(d, m)
+	d.SetId(strconv.FormatInt(tunnel.ID, 10))
+
+	return resourceNetboxVpnTunnelRead(ctx, d, m)
 }
 
-func resourceNetboxVpnTunnelRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := vpn.NewVpnTunnelsReadParams().WithID(id)
 
-	res, err := api.Vpn.VpnTunnelsRead(params, nil)
-	if err != nil {
-		if errresp, ok := err.(*vpn.VpnTunnelsReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+	var tunnel netboxVpnTunnel
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, vpnTunnelPath(id), nil, nil, &tunnel); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
-	tunnel := res.GetPayload()
 	d.Set("name", tunnel.Name)
 	d.Set("encapsulation", tunnel.Encapsulation.Value)
 	d.Set("status", tunnel.Status.Value)
@@ -128,45 +162,40 @@ func resourceNetboxVpnTunnelRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("tunnel_id", tunnel.TunnelID)
 
+	if tunnel.IpsecProfile != nil {
+		d.Set("ipsec_profile_id", tunnel.IpsecProfile.ID)
+	} else {
+		d.Set("ipsec_profile_id", nil)
+	}
+
 	d.Set("description", tunnel.Description)
 
-	api.readTags(d, res.GetPayload().Tags)
+	api.readTags(d, tunnel.Tags)
+	api.readCustomFields(d, tunnel.CustomFields)
 	return nil
 }
 
-func resourceNetboxVpnTunnelUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
-
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	data := models.WritableTunnel{}
-
-	data.Name = strToPtr(d.Get("name").(string))
-	data.Encapsulation = strToPtr(d.Get("encapsulation").(string))
-	data.Status = strToPtr(d.Get("status").(string))
-	data.Group = int64ToPtr(int64(d.Get("tunnel_group_id").(int)))
-
-	data.Description = getOptionalStr(d, "description", false)
-	data.Tenant = getOptionalInt(d, "tenant_id")
-	data.TunnelID = getOptionalInt(d, "tunnel_id")
-
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
-	data.Tags = tags
 
-	params := vpn.NewVpnTunnelsUpdateParams().WithID(id).WithData(&data)
-
-	_, err := api.Vpn.VpnTunnelsUpdate(params, nil)
+	data, err := resourceNetboxVpnTunnelData(ctx, api, d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPut, vpnTunnelPath(id), nil, data, nil); err != nil {
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxVpnTunnelRead(d, m)
+	return resourceNetboxVpnTunnelRead(ctx, d, m)
 }
 
-func resourceNetboxVpnTunnelDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := vpn.NewVpnTunnelsDeleteParams().WithID(id)
+	params := vpn.NewVpnTunnelsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Vpn.VpnTunnelsDelete(params, nil)
 	if err != nil {
@@ -176,7 +205,7 @@ func resourceNetboxVpnTunnelDelete(d *schema.ResourceData, m interface{}) error
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
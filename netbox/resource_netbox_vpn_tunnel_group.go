@@ -1,20 +1,22 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/vpn"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNetboxVpnTunnelGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxVpnTunnelGroupCreate,
-		Read:   resourceNetboxVpnTunnelGroupRead,
-		Update: resourceNetboxVpnTunnelGroupUpdate,
-		Delete: resourceNetboxVpnTunnelGroupDelete,
+		CreateContext: resourceNetboxVpnTunnelGroupCreate,
+		ReadContext:   resourceNetboxVpnTunnelGroupRead,
+		UpdateContext: resourceNetboxVpnTunnelGroupUpdate,
+		DeleteContext: resourceNetboxVpnTunnelGroupDelete,
 
 		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/features/vpn-tunnels/):
 
@@ -35,6 +37,8 @@ func resourceNetboxVpnTunnelGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -42,7 +46,7 @@ func resourceNetboxVpnTunnelGroup() *schema.Resource {
 	}
 }
 
-func resourceNetboxVpnTunnelGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.TunnelGroup{}
@@ -66,22 +70,23 @@ func resourceNetboxVpnTunnelGroupCreate(d *schema.ResourceData, m interface{}) e
 
 	data.Tags = []*models.NestedTag{}
 
-	params := vpn.NewVpnTunnelGroupsCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := vpn.NewVpnTunnelGroupsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Vpn.VpnTunnelGroupsCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxVpnTunnelGroupRead(d, m)
+	return resourceNetboxVpnTunnelGroupRead(ctx, d, m)
 }
 
-func resourceNetboxVpnTunnelGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := vpn.NewVpnTunnelGroupsReadParams().WithID(id)
+	params := vpn.NewVpnTunnelGroupsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Vpn.VpnTunnelGroupsRead(params, nil)
 	if err != nil {
@@ -93,16 +98,17 @@ func resourceNetboxVpnTunnelGroupRead(d *schema.ResourceData, m interface{}) err
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", res.GetPayload().Name)
 	d.Set("slug", res.GetPayload().Slug)
 	d.Set("description", res.GetPayload().Description)
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	return nil
 }
 
-func resourceNetboxVpnTunnelGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -132,21 +138,22 @@ func resourceNetboxVpnTunnelGroupUpdate(d *schema.ResourceData, m interface{}) e
 
 	data.Tags = []*models.NestedTag{}
 
-	params := vpn.NewVpnTunnelGroupsUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := vpn.NewVpnTunnelGroupsUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Vpn.VpnTunnelGroupsUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxVpnTunnelGroupRead(d, m)
+	return resourceNetboxVpnTunnelGroupRead(ctx, d, m)
 }
 
-func resourceNetboxVpnTunnelGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := vpn.NewVpnTunnelGroupsDeleteParams().WithID(id)
+	params := vpn.NewVpnTunnelGroupsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Vpn.VpnTunnelGroupsDelete(params, nil)
 	if err != nil {
@@ -156,7 +163,7 @@ func resourceNetboxVpnTunnelGroupDelete(d *schema.ResourceData, m interface{}) e
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
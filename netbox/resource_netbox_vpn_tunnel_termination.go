@@ -1,10 +1,12 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/vpn"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,10 +14,10 @@ var resourceNetboxVpnTunnelTerminationRoleOptions = []string{"peer", "hub", "spo
 
 func resourceNetboxVpnTunnelTermination() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxVpnTunnelTerminationCreate,
-		Read:   resourceNetboxVpnTunnelTerminationRead,
-		Update: resourceNetboxVpnTunnelTerminationUpdate,
-		Delete: resourceNetboxVpnTunnelTerminationDelete,
+		CreateContext: resourceNetboxVpnTunnelTerminationCreate,
+		ReadContext:   resourceNetboxVpnTunnelTerminationRead,
+		UpdateContext: resourceNetboxVpnTunnelTerminationUpdate,
+		DeleteContext: resourceNetboxVpnTunnelTerminationDelete,
 
 		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/features/vpn-tunnels/):
 
@@ -45,7 +47,9 @@ func resourceNetboxVpnTunnelTermination() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -53,7 +57,7 @@ func resourceNetboxVpnTunnelTermination() *schema.Resource {
 	}
 }
 
-func resourceNetboxVpnTunnelTerminationCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelTerminationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := models.WritableTunnelTermination{}
@@ -77,26 +81,27 @@ func resourceNetboxVpnTunnelTerminationCreate(d *schema.ResourceData, m interfac
 
 	data.OutsideIP = getOptionalInt(d, "outside_ip_address_id")
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	data.Tags = tags
 
-	params := vpn.NewVpnTunnelTerminationsCreateParams().WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := vpn.NewVpnTunnelTerminationsCreateParams().WithContext(ctx).WithData(&data)
 
 	res, err := api.Vpn.VpnTunnelTerminationsCreate(params, nil)
 	if err != nil {
 		//return errors.New(getTextFromError(err))
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxVpnTunnelTerminationRead(d, m)
+	return resourceNetboxVpnTunnelTerminationRead(ctx, d, m)
 }
 
-func resourceNetboxVpnTunnelTerminationRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelTerminationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := vpn.NewVpnTunnelTerminationsReadParams().WithID(id)
+	params := vpn.NewVpnTunnelTerminationsReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Vpn.VpnTunnelTerminationsRead(params, nil)
 	if err != nil {
@@ -108,7 +113,7 @@ func resourceNetboxVpnTunnelTerminationRead(d *schema.ResourceData, m interface{
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	tunnelTermination := res.GetPayload()
@@ -130,10 +135,11 @@ func resourceNetboxVpnTunnelTerminationRead(d *schema.ResourceData, m interface{
 	}
 
 	api.readTags(d, res.GetPayload().Tags)
+	api.readCustomFields(d, res.GetPayload().CustomFields)
 	return nil
 }
 
-func resourceNetboxVpnTunnelTerminationUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelTerminationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -157,24 +163,25 @@ func resourceNetboxVpnTunnelTerminationUpdate(d *schema.ResourceData, m interfac
 
 	data.OutsideIP = getOptionalInt(d, "outside_ip_address_id")
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsAllKey))
+	tags, _ := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
 	data.Tags = tags
 
-	params := vpn.NewVpnTunnelTerminationsUpdateParams().WithID(id).WithData(&data)
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	params := vpn.NewVpnTunnelTerminationsUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Vpn.VpnTunnelTerminationsUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxVpnTunnelTerminationRead(d, m)
+	return resourceNetboxVpnTunnelTerminationRead(ctx, d, m)
 }
 
-func resourceNetboxVpnTunnelTerminationDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxVpnTunnelTerminationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := vpn.NewVpnTunnelTerminationsDeleteParams().WithID(id)
+	params := vpn.NewVpnTunnelTerminationsDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Vpn.VpnTunnelTerminationsDelete(params, nil)
 	if err != nil {
@@ -184,7 +191,7 @@ func resourceNetboxVpnTunnelTerminationDelete(d *schema.ResourceData, m interfac
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
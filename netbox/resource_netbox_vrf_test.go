@@ -201,6 +201,46 @@ resource "netbox_vrf" "test_enforce_unique_false" {
 	})
 }
 
+func TestAccNetboxVrf_customFields(t *testing.T) {
+	testSlug := "vrf_cf"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	testDependencies := fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["ipam.vrf"]
+}
+`, testField)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDependencies + fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name          = "%[1]s"
+  custom_fields = {"${netbox_custom_field.test.name}" = "core"}
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vrf.test", "custom_fields."+testField, "core"),
+				),
+			},
+			{
+				Config: testDependencies + fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name               = "%[1]s"
+  custom_fields_json = jsonencode({ (netbox_custom_field.test.name) = "edge" })
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vrf.test", "custom_fields_json", fmt.Sprintf(`{"%s":"edge"}`, testField)),
+					resource.TestCheckResourceAttr("netbox_vrf.test", "custom_fields.%", "0"),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_vrf", &resource.Sweeper{
 		Name:         "netbox_vrf",
@@ -1,10 +1,12 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -13,10 +15,10 @@ var resourceNetboxWebhookHTTPMethodOptions = []string{"GET", "POST", "PUT", "PAT
 
 func resourceNetboxWebhook() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetboxWebhookCreate,
-		Read:   resourceNetboxWebhookRead,
-		Update: resourceNetboxWebhookUpdate,
-		Delete: resourceNetboxWebhookDelete,
+		CreateContext: resourceNetboxWebhookCreate,
+		ReadContext:   resourceNetboxWebhookRead,
+		UpdateContext: resourceNetboxWebhookUpdate,
+		DeleteContext: resourceNetboxWebhookDelete,
 
 		Description: `:meta:subcategory:Extras:From the [official documentation](https://docs.netbox.dev/en/stable/integrations/webhooks/):
 
@@ -64,7 +66,7 @@ func resourceNetboxWebhook() *schema.Resource {
 	}
 }
 
-func resourceNetboxWebhookCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	data := &models.Webhook{}
@@ -78,22 +80,22 @@ func resourceNetboxWebhookCreate(d *schema.ResourceData, m interface{}) error {
 	data.HTTPContentType = getOptionalStr(d, "http_content_type", false)
 	data.AdditionalHeaders = getOptionalStr(d, "additional_headers", false)
 
-	params := extras.NewExtrasWebhooksCreateParams().WithData(data)
+	params := extras.NewExtrasWebhooksCreateParams().WithContext(ctx).WithData(data)
 
 	res, err := api.Extras.ExtrasWebhooksCreate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
-	return resourceNetboxWebhookRead(d, m)
+	return resourceNetboxWebhookRead(ctx, d, m)
 }
 
-func resourceNetboxWebhookRead(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := extras.NewExtrasWebhooksReadParams().WithID(id)
+	params := extras.NewExtrasWebhooksReadParams().WithContext(ctx).WithID(id)
 
 	res, err := api.Extras.ExtrasWebhooksRead(params, nil)
 	if err != nil {
@@ -104,7 +106,7 @@ func resourceNetboxWebhookRead(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	webhook := res.GetPayload()
@@ -118,7 +120,7 @@ func resourceNetboxWebhookRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNetboxWebhookUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -135,21 +137,21 @@ func resourceNetboxWebhookUpdate(d *schema.ResourceData, m interface{}) error {
 	data.HTTPContentType = getOptionalStr(d, "http_content_type", false)
 	data.AdditionalHeaders = getOptionalStr(d, "additional_headers", false)
 
-	params := extras.NewExtrasWebhooksUpdateParams().WithID(id).WithData(&data)
+	params := extras.NewExtrasWebhooksUpdateParams().WithContext(ctx).WithID(id).WithData(&data)
 
 	_, err := api.Extras.ExtrasWebhooksUpdate(params, nil)
 	if err != nil {
-		return err
+		return diagFromNetboxError(err, d)
 	}
 
-	return resourceNetboxWebhookRead(d, m)
+	return resourceNetboxWebhookRead(ctx, d, m)
 }
 
-func resourceNetboxWebhookDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNetboxWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api := m.(*providerState)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := extras.NewExtrasWebhooksDeleteParams().WithID(id)
+	params := extras.NewExtrasWebhooksDeleteParams().WithContext(ctx).WithID(id)
 
 	_, err := api.Extras.ExtrasWebhooksDelete(params, nil)
 	if err != nil {
@@ -159,7 +161,7 @@ func resourceNetboxWebhookDelete(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return diagFromNetboxError(err, d)
 	}
 	return nil
 }
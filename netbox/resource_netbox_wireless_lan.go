@@ -0,0 +1,298 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxWirelessLANStatusOptions = []string{"active", "reserved", "disabled", "deprecated"}
+var resourceNetboxWirelessAuthTypeOptions = []string{"open", "wep", "wpa-personal", "wpa-enterprise"}
+var resourceNetboxWirelessAuthCipherOptions = []string{"auto", "tkip", "aes"}
+
+// The generated client does not know the scope of wireless LANs, so they are
+// managed through the REST API directly.
+const wirelessLANsPath = "/wireless/wireless-lans/"
+
+// netboxWirelessLAN is a wireless LAN including its scope.
+type netboxWirelessLAN struct {
+	models.WirelessLAN
+	ScopeType *string `json:"scope_type"`
+	ScopeID   *int64  `json:"scope_id"`
+}
+
+func wirelessLANPath(id int64) string {
+	return fmt.Sprintf("%s%d/", wirelessLANsPath, id)
+}
+
+func resourceNetboxWirelessLAN() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxWirelessLANCreate,
+		ReadContext:   resourceNetboxWirelessLANRead,
+		UpdateContext: resourceNetboxWirelessLANUpdate,
+		DeleteContext: resourceNetboxWirelessLANDelete,
+
+		Description: `:meta:subcategory:Wireless:From the [official documentation](https://docs.netbox.dev/en/stable/models/wireless/wirelesslan/):
+
+> A wireless LAN is a set of interfaces connected via a common wireless channel, identified by its SSID and authentication parameters. Wireless interfaces can be associated with wireless LANs to model multi-access wireless segments.`,
+
+		Schema: map[string]*schema.Schema{
+			"ssid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessLANStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessLANStatusOptions),
+			},
+			"vlan_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"location_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"site_id", "site_group_id", "region_id"},
+			},
+			"site_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"location_id", "site_group_id", "region_id"},
+			},
+			"site_group_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"location_id", "site_id", "region_id"},
+			},
+			"region_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"location_id", "site_id", "site_group_id"},
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessAuthTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessAuthTypeOptions),
+			},
+			"auth_cipher": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessAuthCipherOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessAuthCipherOptions),
+			},
+			"auth_psk": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The pre-shared key.",
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxWirelessLANData(ctx context.Context, api *providerState, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"ssid":        d.Get("ssid").(string),
+		"description": d.Get("description").(string),
+		"comments":    d.Get("comments").(string),
+		"group":       getOptionalInt(d, "group_id"),
+		"status":      d.Get("status").(string),
+		"vlan":        getOptionalInt(d, "vlan_id"),
+		"tenant":      getOptionalInt(d, "tenant_id"),
+		"auth_type":   d.Get("auth_type").(string),
+		"auth_cipher": d.Get("auth_cipher").(string),
+		"auth_psk":    d.Get("auth_psk").(string),
+		"scope_type":  nil,
+		"scope_id":    nil,
+		"tags":        tags,
+	}
+
+	siteID := getOptionalInt(d, "site_id")
+	siteGroupID := getOptionalInt(d, "site_group_id")
+	locationID := getOptionalInt(d, "location_id")
+	regionID := getOptionalInt(d, "region_id")
+
+	switch {
+	case siteID != nil:
+		data["scope_type"] = "dcim.site"
+		data["scope_id"] = siteID
+	case siteGroupID != nil:
+		data["scope_type"] = "dcim.sitegroup"
+		data["scope_id"] = siteGroupID
+	case locationID != nil:
+		data["scope_type"] = "dcim.location"
+		data["scope_id"] = locationID
+	case regionID != nil:
+		data["scope_type"] = "dcim.region"
+		data["scope_id"] = regionID
+	}
+
+	if customFields := api.customFieldsFromResourceData(d); customFields != nil {
+		data["custom_fields"] = customFields
+	}
+	return data, nil
+}
+
+func resourceNetboxWirelessLANCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxWirelessLANData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var wirelessLAN netboxWirelessLAN
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPost, wirelessLANsPath, nil, data, &wirelessLAN); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(wirelessLAN.ID, 10))
+
+	return resourceNetboxWirelessLANRead(ctx, d, m)
+}
+
+func resourceNetboxWirelessLANRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var wirelessLAN netboxWirelessLAN
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, wirelessLANPath(id), nil, nil, &wirelessLAN); err != nil {
+		if isNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("ssid", wirelessLAN.Ssid)
+	d.Set("description", wirelessLAN.Description)
+	d.Set("comments", wirelessLAN.Comments)
+	d.Set("auth_psk", wirelessLAN.AuthPsk)
+
+	if wirelessLAN.Status != nil {
+		d.Set("status", wirelessLAN.Status.Value)
+	}
+
+	if wirelessLAN.AuthType != nil {
+		d.Set("auth_type", wirelessLAN.AuthType.Value)
+	} else {
+		d.Set("auth_type", nil)
+	}
+
+	if wirelessLAN.AuthCipher != nil {
+		d.Set("auth_cipher", wirelessLAN.AuthCipher.Value)
+	} else {
+		d.Set("auth_cipher", nil)
+	}
+
+	if wirelessLAN.Group != nil {
+		d.Set("group_id", wirelessLAN.Group.ID)
+	} else {
+		d.Set("group_id", nil)
+	}
+
+	if wirelessLAN.Vlan != nil {
+		d.Set("vlan_id", wirelessLAN.Vlan.ID)
+	} else {
+		d.Set("vlan_id", nil)
+	}
+
+	if wirelessLAN.Tenant != nil {
+		d.Set("tenant_id", wirelessLAN.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	d.Set("site_id", nil)
+	d.Set("site_group_id", nil)
+	d.Set("location_id", nil)
+	d.Set("region_id", nil)
+
+	if wirelessLAN.ScopeType != nil && wirelessLAN.ScopeID != nil {
+		scopeID := wirelessLAN.ScopeID
+		switch scopeType := wirelessLAN.ScopeType; *scopeType {
+		case "dcim.site":
+			d.Set("site_id", scopeID)
+		case "dcim.sitegroup":
+			d.Set("site_group_id", scopeID)
+		case "dcim.location":
+			d.Set("location_id", scopeID)
+		case "dcim.region":
+			d.Set("region_id", scopeID)
+		}
+	}
+
+	api.readTags(d, wirelessLAN.Tags)
+	api.readCustomFields(d, wirelessLAN.CustomFields)
+	return nil
+}
+
+func resourceNetboxWirelessLANUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxWirelessLANData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodPut, wirelessLANPath(id), nil, data, nil); err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxWirelessLANRead(ctx, d, m)
+}
+
+func resourceNetboxWirelessLANDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodDelete, wirelessLANPath(id), nil, nil, nil); err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
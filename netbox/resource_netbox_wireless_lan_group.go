@@ -0,0 +1,171 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/wireless"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxWirelessLANGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxWirelessLANGroupCreate,
+		ReadContext:   resourceNetboxWirelessLANGroupRead,
+		UpdateContext: resourceNetboxWirelessLANGroupUpdate,
+		DeleteContext: resourceNetboxWirelessLANGroupDelete,
+
+		Description: `:meta:subcategory:Wireless:From the [official documentation](https://docs.netbox.dev/en/stable/models/wireless/wirelesslangroup/):
+
+> Wireless LAN groups can be used to organize and classify wireless LANs. These groups are hierarchical: groups can be nested within parent groups. However, each wireless LAN may be assigned only to one group.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"parent_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxWirelessLANGroupData(ctx context.Context, api *providerState, d *schema.ResourceData) (*models.WritableWirelessLANGroup, error) {
+	name := d.Get("name").(string)
+
+	slugValue, slugOk := d.GetOk("slug")
+	var slug string
+	// Default slug to generated slug if not given
+	if !slugOk {
+		slug = getSlug(name)
+	} else {
+		slug = slugValue.(string)
+	}
+
+	data := &models.WritableWirelessLANGroup{}
+	data.Name = &name
+	data.Slug = &slug
+	data.Description = d.Get("description").(string)
+	data.Parent = getOptionalInt(d, "parent_id")
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	return data, nil
+}
+
+func resourceNetboxWirelessLANGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxWirelessLANGroupData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := wireless.NewWirelessWirelessLanGroupsCreateParams().WithContext(ctx).WithData(data)
+
+	res, err := api.Wireless.WirelessWirelessLanGroupsCreate(params, nil)
+	if err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxWirelessLANGroupRead(ctx, d, m)
+}
+
+func resourceNetboxWirelessLANGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := wireless.NewWirelessWirelessLanGroupsReadParams().WithContext(ctx).WithID(id)
+
+	res, err := api.Wireless.WirelessWirelessLanGroupsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*wireless.WirelessWirelessLanGroupsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	group := res.GetPayload()
+	d.Set("name", group.Name)
+	d.Set("slug", group.Slug)
+	d.Set("description", group.Description)
+	if group.Parent != nil {
+		d.Set("parent_id", group.Parent.ID)
+	} else {
+		d.Set("parent_id", nil)
+	}
+	api.readTags(d, group.Tags)
+	api.readCustomFields(d, group.CustomFields)
+	return nil
+}
+
+func resourceNetboxWirelessLANGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxWirelessLANGroupData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := wireless.NewWirelessWirelessLanGroupsUpdateParams().WithContext(ctx).WithID(id).WithData(data)
+
+	_, err = api.Wireless.WirelessWirelessLanGroupsUpdate(params, nil)
+	if err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxWirelessLANGroupRead(ctx, d, m)
+}
+
+func resourceNetboxWirelessLANGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := wireless.NewWirelessWirelessLanGroupsDeleteParams().WithContext(ctx).WithID(id)
+
+	_, err := api.Wireless.WirelessWirelessLanGroupsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*wireless.WirelessWirelessLanGroupsDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client/wireless"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxWirelessLANGroup_basic(t *testing.T) {
+	testSlug := "wlan_group_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]sa"
+}
+
+resource "netbox_wireless_lan_group" "parent" {
+  name = "%[1]s-parent"
+}
+
+resource "netbox_wireless_lan_group" "test" {
+  name        = "%[1]s"
+  parent_id   = netbox_wireless_lan_group.parent.id
+  description = "test"
+  tags        = [netbox_tag.test.name]
+}
+
+data "netbox_wireless_lan_group" "test" {
+  slug = netbox_wireless_lan_group.test.slug
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "description", "test"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan_group.test", "parent_id", "netbox_wireless_lan_group.parent", "id"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan_group.test", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_lan_group.test", "id", "netbox_wireless_lan_group.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_lan_group.test", "parent_id", "netbox_wireless_lan_group.parent", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_wireless_lan_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_wireless_lan_group", &resource.Sweeper{
+		Name:         "netbox_wireless_lan_group",
+		Dependencies: []string{"netbox_wireless_lan"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*providerState)
+			params := wireless.NewWirelessWirelessLanGroupsListParams()
+			res, err := api.Wireless.WirelessWirelessLanGroupsList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, group := range res.GetPayload().Results {
+				if strings.HasPrefix(*group.Name, testPrefix) {
+					deleteParams := wireless.NewWirelessWirelessLanGroupsDeleteParams().WithID(group.ID)
+					_, err := api.Wireless.WirelessWirelessLanGroupsDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a wireless lan group")
+				}
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,122 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxWirelessLAN_basic(t *testing.T) {
+	testSlug := "wlan_basic"
+	testName := testAccGetTestName(testSlug)
+	dependencies := fmt.Sprintf(`
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_site" "test" {
+  name   = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_vlan" "test" {
+  name = "%[1]s"
+  vid  = 1234
+}
+
+resource "netbox_wireless_lan_group" "test" {
+  name = "%[1]s"
+}
+`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_wireless_lan" "test" {
+  ssid        = "%[1]s"
+  group_id    = netbox_wireless_lan_group.test.id
+  status      = "reserved"
+  vlan_id     = netbox_vlan.test.id
+  tenant_id   = netbox_tenant.test.id
+  site_id     = netbox_site.test.id
+  auth_type   = "wpa-personal"
+  auth_cipher = "aes"
+  auth_psk    = "correct horse battery staple"
+  description = "test"
+}
+
+data "netbox_wireless_lan" "test" {
+  ssid     = netbox_wireless_lan.test.ssid
+  group_id = netbox_wireless_lan_group.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "ssid", testName),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "status", "reserved"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_type", "wpa-personal"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_cipher", "aes"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_psk", "correct horse battery staple"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan.test", "group_id", "netbox_wireless_lan_group.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan.test", "vlan_id", "netbox_vlan.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan.test", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_lan.test", "site_id", "netbox_site.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_lan.test", "id", "netbox_wireless_lan.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_wireless_lan.test", "scope_type", "dcim.site"),
+					resource.TestCheckResourceAttr("data.netbox_wireless_lan.test", "auth_psk", "correct horse battery staple"),
+				),
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_wireless_lan" "test" {
+  ssid = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "auth_type", ""),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "group_id", "0"),
+					resource.TestCheckResourceAttr("netbox_wireless_lan.test", "site_id", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_wireless_lan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_wireless_lan", &resource.Sweeper{
+		Name:         "netbox_wireless_lan",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*providerState)
+			var res struct {
+				Results []*netboxWirelessLAN `json:"results"`
+			}
+			if err := netboxAPIRequest(context.Background(), api.Transport, http.MethodGet, wirelessLANsPath, nil, nil, &res); err != nil {
+				return err
+			}
+			for _, wirelessLAN := range res.Results {
+				if strings.HasPrefix(*wirelessLAN.Ssid, testPrefix) {
+					if err := netboxAPIRequest(context.Background(), api.Transport, http.MethodDelete, wirelessLANPath(wirelessLAN.ID), nil, nil, nil); err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a wireless lan")
+				}
+			}
+			return nil
+		},
+	})
+}
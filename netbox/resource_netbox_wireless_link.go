@@ -0,0 +1,229 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/wireless"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxWirelessLinkStatusOptions = []string{"connected", "planned", "decommissioning"}
+
+func resourceNetboxWirelessLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxWirelessLinkCreate,
+		ReadContext:   resourceNetboxWirelessLinkRead,
+		UpdateContext: resourceNetboxWirelessLinkUpdate,
+		DeleteContext: resourceNetboxWirelessLinkDelete,
+
+		Description: `:meta:subcategory:Wireless:From the [official documentation](https://docs.netbox.dev/en/stable/models/wireless/wirelesslink/):
+
+> A wireless link represents a connection between exactly two wireless interfaces. It may optionally be assigned an SSID and a description. It may also have a status assigned to it, similar to the cable model.
+
+Both interfaces must be wireless interfaces, e.g. a ` + "`netbox_device_interface`" + ` of type ` + "`ieee802.11ac`" + `.`,
+
+		Schema: map[string]*schema.Schema{
+			"interface_a_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"interface_b_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"ssid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "connected",
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessLinkStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessLinkStatusOptions),
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessAuthTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessAuthTypeOptions),
+			},
+			"auth_cipher": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxWirelessAuthCipherOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxWirelessAuthCipherOptions),
+			},
+			"auth_psk": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+				Description:  "The pre-shared key.",
+			},
+			customFieldsKey:     customFieldsSchema,
+			customFieldsJSONKey: customFieldsJSONSchema,
+			tagsKey:             tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxWirelessLinkData(ctx context.Context, api *providerState, d *schema.ResourceData) (*models.WritableWirelessLink, error) {
+	data := &models.WritableWirelessLink{}
+
+	data.Interfacea = int64ToPtr(int64(d.Get("interface_a_id").(int)))
+	data.Interfaceb = int64ToPtr(int64(d.Get("interface_b_id").(int)))
+	data.Ssid = d.Get("ssid").(string)
+	data.Status = d.Get("status").(string)
+	data.Tenant = getOptionalInt(d, "tenant_id")
+	data.Description = d.Get("description").(string)
+	data.Comments = d.Get("comments").(string)
+	data.AuthType = d.Get("auth_type").(string)
+	data.AuthCipher = d.Get("auth_cipher").(string)
+	data.AuthPsk = d.Get("auth_psk").(string)
+
+	var err error
+	data.Tags, err = getNestedTagListFromResourceDataSet(ctx, api, d.Get(tagsAllKey))
+	if err != nil {
+		return nil, err
+	}
+
+	data.CustomFields = api.customFieldsFromResourceData(d)
+	return data, nil
+}
+
+func resourceNetboxWirelessLinkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	data, err := resourceNetboxWirelessLinkData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := wireless.NewWirelessWirelessLinksCreateParams().WithContext(ctx).WithData(data)
+
+	res, err := api.Wireless.WirelessWirelessLinksCreate(params, nil)
+	if err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxWirelessLinkRead(ctx, d, m)
+}
+
+func resourceNetboxWirelessLinkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := wireless.NewWirelessWirelessLinksReadParams().WithContext(ctx).WithID(id)
+
+	res, err := api.Wireless.WirelessWirelessLinksRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*wireless.WirelessWirelessLinksReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	link := res.GetPayload()
+	if link.Interfacea != nil {
+		d.Set("interface_a_id", link.Interfacea.ID)
+	}
+	if link.Interfaceb != nil {
+		d.Set("interface_b_id", link.Interfaceb.ID)
+	}
+	d.Set("ssid", link.Ssid)
+	d.Set("description", link.Description)
+	d.Set("comments", link.Comments)
+	d.Set("auth_psk", link.AuthPsk)
+
+	if link.Status != nil {
+		d.Set("status", link.Status.Value)
+	}
+
+	if link.AuthType != nil {
+		d.Set("auth_type", link.AuthType.Value)
+	} else {
+		d.Set("auth_type", nil)
+	}
+
+	if link.AuthCipher != nil {
+		d.Set("auth_cipher", link.AuthCipher.Value)
+	} else {
+		d.Set("auth_cipher", nil)
+	}
+
+	if link.Tenant != nil {
+		d.Set("tenant_id", link.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	api.readTags(d, link.Tags)
+	api.readCustomFields(d, link.CustomFields)
+	return nil
+}
+
+func resourceNetboxWirelessLinkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := resourceNetboxWirelessLinkData(ctx, api, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := wireless.NewWirelessWirelessLinksUpdateParams().WithContext(ctx).WithID(id).WithData(data)
+
+	_, err = api.Wireless.WirelessWirelessLinksUpdate(params, nil)
+	if err != nil {
+		return diagFromNetboxError(err, d)
+	}
+
+	return resourceNetboxWirelessLinkRead(ctx, d, m)
+}
+
+func resourceNetboxWirelessLinkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*providerState)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := wireless.NewWirelessWirelessLinksDeleteParams().WithContext(ctx).WithID(id)
+
+	_, err := api.Wireless.WirelessWirelessLinksDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*wireless.WirelessWirelessLinksDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diagFromNetboxError(err, d)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxWirelessLink_basic(t *testing.T) {
+	testSlug := "wlink_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name   = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device_role" "test" {
+  name      = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_device" "test" {
+  count          = 2
+  name           = "%[1]s-${count.index}"
+  device_type_id = netbox_device_type.test.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "test" {
+  count     = 2
+  name      = "wlan0"
+  device_id = netbox_device.test[count.index].id
+  type      = "ieee802.11ac"
+}
+
+resource "netbox_wireless_link" "test" {
+  interface_a_id = netbox_device_interface.test[0].id
+  interface_b_id = netbox_device_interface.test[1].id
+  ssid           = "%[1]s"
+  status         = "planned"
+  auth_type      = "wpa-personal"
+  auth_cipher    = "aes"
+  auth_psk       = "correct horse battery staple"
+  description    = "test"
+}
+
+data "netbox_wireless_link" "test" {
+  interface_a_id = netbox_wireless_link.test.interface_a_id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_wireless_link.test", "interface_a_id", "netbox_device_interface.test.0", "id"),
+					resource.TestCheckResourceAttrPair("netbox_wireless_link.test", "interface_b_id", "netbox_device_interface.test.1", "id"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "status", "planned"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "auth_type", "wpa-personal"),
+					resource.TestCheckResourceAttr("netbox_wireless_link.test", "auth_psk", "correct horse battery staple"),
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_link.test", "id", "netbox_wireless_link.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_wireless_link.test", "interface_b_id", "netbox_device_interface.test.1", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_wireless_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
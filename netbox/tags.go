@@ -1,8 +1,10 @@
 package netbox
 
 import (
+	"context"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/extras"
@@ -42,14 +44,14 @@ var tagsSchemaRead = &schema.Schema{
 	Set:      schema.HashString,
 }
 
-func getNestedTagListFromResourceDataSet(state *providerState, d interface{}) ([]*models.NestedTag, error) {
+func getNestedTagListFromResourceDataSet(ctx context.Context, state *providerState, d interface{}) ([]*models.NestedTag, error) {
 	tagList := d.(*schema.Set).List()
 	tags := []*models.NestedTag{}
 	for _, tag := range tagList {
 		nbTag, ok := state.tagCache[tag.(string)]
 		if !ok {
 			var err error
-			nbTag, err = findTag(state.NetBoxAPI, tag.(string))
+			nbTag, err = findTag(ctx, state.NetBoxAPI, tag.(string))
 			if err != nil {
 				return tags, err
 			}
@@ -61,8 +63,8 @@ func getNestedTagListFromResourceDataSet(state *providerState, d interface{}) ([
 	return tags, nil
 }
 
-func findTag(client *client.NetBoxAPI, name string) (*models.NestedTag, error) {
-	params := extras.NewExtrasTagsListParams()
+func findTag(ctx context.Context, client *client.NetBoxAPI, name string) (*models.NestedTag, error) {
+	params := extras.NewExtrasTagsListParams().WithContext(ctx)
 	params.Name = &name
 
 	limit := int64(2) // We search for a unique tag. Having two hits suffices to know its not unique.
@@ -117,12 +119,26 @@ func (s *providerState) readTags(d *schema.ResourceData, apiTags []*models.Neste
 	}
 
 	resourceTags := schema.NewSet(schema.HashString, nil)
-	// remove default tags (except when configured on the resource)
+	// remove default and ignored tags (except when configured on the resource)
 	for _, tag := range apiTags {
-		if !s.defaultTags.Contains(*tag.Name) || slices.Contains(configTags, *tag.Name) {
+		if slices.Contains(configTags, *tag.Name) || (!s.defaultTags.Contains(*tag.Name) && !s.tagIgnored(*tag.Name)) {
 			resourceTags.Add(*tag.Name)
 		}
 	}
 
 	d.Set(tagsKey, resourceTags.List())
 }
+
+// tagIgnored reports whether the given tag is managed outside of Terraform,
+// see the `ignore_tags` provider attribute.
+func (s *providerState) tagIgnored(name string) bool {
+	if slices.Contains(s.ignoreTags, name) {
+		return true
+	}
+	for _, prefix := range s.ignoreTagPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
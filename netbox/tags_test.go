@@ -1,10 +1,15 @@
 package netbox
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetTagListFromNestedTagList(t *testing.T) {
@@ -26,3 +31,51 @@ func TestGetTagListFromNestedTagList(t *testing.T) {
 	}
 	assert.Equal(t, flat, expected)
 }
+
+func TestTagIgnored(t *testing.T) {
+	state := providerState{
+		ignoreTags:        []string{"discovered"},
+		ignoreTagPrefixes: []string{"scan:", "ui-"},
+	}
+
+	assert.True(t, state.tagIgnored("discovered"))
+	assert.True(t, state.tagIgnored("scan:weekly"))
+	assert.True(t, state.tagIgnored("ui-favorite"))
+	assert.False(t, state.tagIgnored("discovered-by-hand"))
+	assert.False(t, state.tagIgnored("managed"))
+}
+
+func TestReadTagsIgnored(t *testing.T) {
+	state := providerState{
+		defaultTags:       schema.NewSet(schema.HashString, nil),
+		ignoreTagPrefixes: []string{"scan:"},
+	}
+	d := (&schema.Resource{Schema: map[string]*schema.Schema{
+		tagsKey:    tagsSchema,
+		tagsAllKey: tagsAllSchema,
+	}}).TestResourceData()
+
+	state.readTags(d, []*models.NestedTag{
+		{Name: strToPtr("managed"), Slug: strToPtr("managed")},
+		{Name: strToPtr("scan:weekly"), Slug: strToPtr("scan-weekly")},
+	})
+
+	assert.ElementsMatch(t, []interface{}{"managed"}, d.Get(tagsKey).(*schema.Set).List())
+	assert.ElementsMatch(t, []interface{}{"managed", "scan:weekly"}, d.Get(tagsAllKey).(*schema.Set).List())
+}
+
+func TestFindTagCanceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	netboxClient, err := config.Client()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = findTag(ctx, netboxClient, "foo")
+	assert.ErrorIs(t, err, context.Canceled)
+}
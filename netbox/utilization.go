@@ -0,0 +1,273 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+)
+
+// The Netbox API does not return the utilization of prefixes and IP ranges,
+// so it is calculated the same way the Netbox UI does.
+
+// prefixUtilization returns the utilization of a prefix in percent. Container
+// prefixes are utilized by their child prefixes, all other prefixes by their
+// IP addresses and the IP ranges marked as utilized.
+func prefixUtilization(ctx context.Context, api *providerState, prefix *models.Prefix) (float64, error) {
+	if prefix.MarkUtilized {
+		return 100, nil
+	}
+
+	cidr, err := netip.ParsePrefix(*prefix.Prefix)
+	if err != nil {
+		return 0, err
+	}
+	size := prefixSize(cidr)
+
+	if prefix.Status != nil && prefix.Status.Value != nil && *prefix.Status.Value == "container" {
+		params := ipam.NewIpamPrefixesAvailablePrefixesListParams().WithContext(ctx).WithID(prefix.ID)
+		res, err := api.Ipam.IpamPrefixesAvailablePrefixesList(params, nil)
+		if err != nil {
+			return 0, err
+		}
+		available := 0.0
+		for _, availablePrefix := range res.GetPayload() {
+			p, err := netip.ParsePrefix(availablePrefix.Prefix)
+			if err != nil {
+				return 0, err
+			}
+			available += prefixSize(p)
+		}
+		return utilizationPercent(size-available, size), nil
+	}
+
+	// the network and broadcast addresses can not be used
+	if cidr.Addr().Is4() && cidr.Bits() < 31 && !prefix.IsPool {
+		size -= 2
+	}
+
+	vrfID := "null"
+	if prefix.Vrf != nil {
+		vrfID = strconv.FormatInt(prefix.Vrf.ID, 10)
+	}
+
+	ranges, err := fetchAllPages(0, api.pageConcurrency, func(limit, offset int64) ([]*models.IPRange, int64, error) {
+		var res struct {
+			Count   int64             `json:"count"`
+			Results []*models.IPRange `json:"results"`
+		}
+		query := url.Values{
+			"parent":        {cidr.String()},
+			"vrf_id":        {vrfID},
+			"mark_utilized": {"true"},
+			"limit":         {strconv.FormatInt(limit, 10)},
+			"offset":        {strconv.FormatInt(offset, 10)},
+		}
+		// the generated client can not filter IP ranges by parent
+		if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, "/ipam/ip-ranges/", query, nil, &res); err != nil {
+			return nil, 0, err
+		}
+		return res.Results, res.Count, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	addresses, err := childIPAddresses(ctx, api, cidr, vrfID)
+	if err != nil {
+		return 0, err
+	}
+
+	used := 0.0
+	var utilizedRanges [][2]netip.Addr
+	for _, ipRange := range ranges {
+		start, end, err := ipRangeBounds(ipRange)
+		if err != nil {
+			return 0, err
+		}
+		utilizedRanges = append(utilizedRanges, [2]netip.Addr{start, end})
+		used += float64(ipRange.Size)
+	}
+	for _, address := range addresses {
+		inRange := false
+		for _, bounds := range utilizedRanges {
+			if address.Compare(bounds[0]) >= 0 && address.Compare(bounds[1]) <= 0 {
+				inRange = true
+				break
+			}
+		}
+		if !inRange {
+			used++
+		}
+	}
+
+	return utilizationPercent(used, size), nil
+}
+
+// utilizedIPRange is an IP range including the mark_utilized flag, which the
+// generated client does not know.
+type utilizedIPRange struct {
+	models.IPRange
+	MarkUtilized bool `json:"mark_utilized"`
+}
+
+// ipRangeUtilization returns the IP range with the given ID and its
+// utilization in percent.
+func ipRangeUtilization(ctx context.Context, api *providerState, id int64) (*models.IPRange, float64, error) {
+	var ipRange utilizedIPRange
+	if err := netboxAPIRequest(ctx, api.Transport, http.MethodGet, fmt.Sprintf("/ipam/ip-ranges/%d/", id), nil, nil, &ipRange); err != nil {
+		return nil, 0, err
+	}
+	if ipRange.MarkUtilized {
+		return &ipRange.IPRange, 100, nil
+	}
+
+	start, end, err := ipRangeBounds(&ipRange.IPRange)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	vrfID := "null"
+	if ipRange.Vrf != nil {
+		vrfID = strconv.FormatInt(ipRange.Vrf.ID, 10)
+	}
+
+	addresses, err := childIPAddresses(ctx, api, coveringPrefix(start, end), vrfID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	used := 0.0
+	for _, address := range addresses {
+		if address.Compare(start) >= 0 && address.Compare(end) <= 0 {
+			used++
+		}
+	}
+	return &ipRange.IPRange, utilizationPercent(used, float64(ipRange.Size)), nil
+}
+
+// childIPAddresses returns the addresses of all IP addresses within the
+// given prefix and VRF. A VRF ID of `null` selects the global table.
+func childIPAddresses(ctx context.Context, api *providerState, parent netip.Prefix, vrfID string) ([]netip.Addr, error) {
+	parentStr := parent.String()
+	ipAddresses, err := fetchAllPages(0, api.pageConcurrency, func(limit, offset int64) ([]*models.IPAddress, int64, error) {
+		params := ipam.NewIpamIPAddressesListParams().WithContext(ctx).WithLimit(&limit).WithOffset(&offset)
+		params.Parent = &parentStr
+		params.VrfID = &vrfID
+		res, err := api.Ipam.IpamIPAddressesList(params, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.GetPayload().Results, *res.GetPayload().Count, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]netip.Addr, 0, len(ipAddresses))
+	for _, ipAddress := range ipAddresses {
+		p, err := netip.ParsePrefix(*ipAddress.Address)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, p.Addr())
+	}
+	return addresses, nil
+}
+
+func ipRangeBounds(ipRange *models.IPRange) (netip.Addr, netip.Addr, error) {
+	start, err := netip.ParsePrefix(*ipRange.StartAddress)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, err
+	}
+	end, err := netip.ParsePrefix(*ipRange.EndAddress)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, err
+	}
+	return start.Addr(), end.Addr(), nil
+}
+
+// coveringPrefix returns the smallest prefix containing both addresses.
+func coveringPrefix(start, end netip.Addr) netip.Prefix {
+	for bits := start.BitLen(); bits > 0; bits-- {
+		p, _ := start.Prefix(bits)
+		if p.Contains(end) {
+			return p
+		}
+	}
+	p, _ := start.Prefix(0)
+	return p
+}
+
+// prefixSize returns the number of addresses in a prefix. A float is used,
+// as IPv6 prefixes can hold more addresses than fit into an integer.
+func prefixSize(p netip.Prefix) float64 {
+	return math.Ldexp(1, p.Addr().BitLen()-p.Bits())
+}
+
+func utilizationPercent(used, size float64) float64 {
+	if size <= 0 {
+		return 100
+	}
+	return math.Min(used/size*100, 100)
+}
+
+// availablePrefixesOfLength splits the available prefixes into prefixes of
+// the given length, in order, and returns at most limit of them. Available
+// prefixes smaller than the requested length are skipped.
+func availablePrefixesOfLength(available []*models.AvailablePrefix, length int, limit int) ([]*models.AvailablePrefix, error) {
+	var result []*models.AvailablePrefix
+	for _, availablePrefix := range available {
+		p, err := netip.ParsePrefix(availablePrefix.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		if length > p.Addr().BitLen() {
+			return nil, fmt.Errorf("prefix length %d is invalid for %s", length, p)
+		}
+		if p.Bits() > length {
+			continue
+		}
+
+		addr := p.Masked().Addr()
+		for len(result) < limit && p.Contains(addr) {
+			subnet := netip.PrefixFrom(addr, length)
+			result = append(result, &models.AvailablePrefix{
+				Family: availablePrefix.Family,
+				Prefix: subnet.String(),
+				Vrf:    availablePrefix.Vrf,
+			})
+			addr = lastAddr(subnet).Next()
+			if !addr.IsValid() {
+				break
+			}
+		}
+		if len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// lastAddr returns the last address of a prefix.
+func lastAddr(p netip.Prefix) netip.Addr {
+	b := p.Masked().Addr().As16()
+	offset := 0
+	if p.Addr().Is4() {
+		offset = 96
+	}
+	for i := offset + p.Bits(); i < 128; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+	addr := netip.AddrFrom16(b)
+	if p.Addr().Is4() {
+		return addr.Unmap()
+	}
+	return addr
+}
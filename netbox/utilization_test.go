@@ -0,0 +1,97 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvailablePrefixesOfLength(t *testing.T) {
+	available := []*models.AvailablePrefix{
+		{Family: 4, Prefix: "10.0.0.16/28"},
+		{Family: 4, Prefix: "10.0.0.32/27"},
+		{Family: 4, Prefix: "10.0.0.64/26"},
+	}
+
+	result, err := availablePrefixesOfLength(available, 27, 10)
+	require.NoError(t, err)
+	var prefixes []string
+	for _, p := range result {
+		prefixes = append(prefixes, p.Prefix)
+	}
+	assert.Equal(t, []string{"10.0.0.32/27", "10.0.0.64/27", "10.0.0.96/27"}, prefixes)
+
+	result, err = availablePrefixesOfLength(available, 28, 2)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "10.0.0.16/28", result[0].Prefix)
+	assert.Equal(t, "10.0.0.32/28", result[1].Prefix)
+
+	result, err = availablePrefixesOfLength([]*models.AvailablePrefix{{Family: 4, Prefix: "255.255.255.252/30"}}, 32, 10)
+	require.NoError(t, err)
+	assert.Len(t, result, 4)
+
+	_, err = availablePrefixesOfLength(available, 64, 10)
+	assert.Error(t, err)
+}
+
+func TestCoveringPrefix(t *testing.T) {
+	assert.Equal(t, "10.0.0.0/28", coveringPrefix(netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("10.0.0.14")).String())
+	assert.Equal(t, "10.0.0.0/24", coveringPrefix(netip.MustParseAddr("10.0.0.100"), netip.MustParseAddr("10.0.0.200")).String())
+	assert.Equal(t, "2001:db8::1/128", coveringPrefix(netip.MustParseAddr("2001:db8::1"), netip.MustParseAddr("2001:db8::1")).String())
+}
+
+func TestPrefixUtilization(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/ipam/ip-ranges/":
+			assert.Equal(t, "10.0.0.0/28", r.URL.Query().Get("parent"))
+			assert.Equal(t, "null", r.URL.Query().Get("vrf_id"))
+			json.NewEncoder(w).Encode(map[string]interface{}{"count": 1, "results": []map[string]interface{}{
+				{"id": 1, "start_address": "10.0.0.8/28", "end_address": "10.0.0.11/28", "size": 4},
+			}})
+		case "/api/ipam/ip-addresses/":
+			json.NewEncoder(w).Encode(map[string]interface{}{"count": 3, "results": []map[string]interface{}{
+				{"id": 1, "address": "10.0.0.1/28"},
+				{"id": 2, "address": "10.0.0.2/28"},
+				{"id": 3, "address": "10.0.0.9/28"},
+			}})
+		case "/api/ipam/prefixes/2/available-prefixes/":
+			json.NewEncoder(w).Encode([]map[string]interface{}{
+				{"family": 4, "prefix": "10.1.0.0/25"},
+				{"family": 4, "prefix": "10.1.0.128/26"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	netboxClient, err := config.Client()
+	require.NoError(t, err)
+	api := &providerState{NetBoxAPI: netboxClient, defaultTags: schema.NewSet(schema.HashString, nil)}
+
+	// 2 addresses and a utilized range of 4 of 14 usable addresses
+	utilization, err := prefixUtilization(context.Background(), api, &models.Prefix{ID: 1, Prefix: strToPtr("10.0.0.0/28")})
+	require.NoError(t, err)
+	assert.InDelta(t, 6.0/14*100, utilization, 0.001)
+
+	container := "container"
+	utilization, err = prefixUtilization(context.Background(), api, &models.Prefix{ID: 2, Prefix: strToPtr("10.1.0.0/24"), Status: &models.PrefixStatus{Value: &container}})
+	require.NoError(t, err)
+	assert.InDelta(t, 25.0, utilization, 0.001)
+
+	utilization, err = prefixUtilization(context.Background(), api, &models.Prefix{ID: 3, Prefix: strToPtr("10.2.0.0/24"), MarkUtilized: true})
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, utilization)
+}